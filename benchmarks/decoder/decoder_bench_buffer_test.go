@@ -0,0 +1,138 @@
+package benchmarks
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/francoispqt/gojay"
+	"github.com/francoispqt/gojay/benchmarks"
+)
+
+type ints []int
+
+func (v *ints) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var i int
+	if err := dec.Int(&i); err != nil {
+		return err
+	}
+	*v = append(*v, i)
+	return nil
+}
+
+var intsFixture, intsStreamFixture, smallStreamFixture = func() ([]byte, []byte, []byte) {
+	var array, stream, small = []byte{'['}, []byte{}, []byte{}
+	for i := 0; i < 1000; i++ {
+		if i > 0 {
+			array = append(array, ',')
+		}
+		array = strconv.AppendInt(array, int64(i), 10)
+		stream = append(strconv.AppendInt(stream, int64(i), 10), '\n')
+		small = append(append(small, benchmarks.SmallFixture...), '\n')
+	}
+	return append(array, ']'), stream, small
+}()
+
+// Unmarshal copies the input to a pooled buffer, reused when no decoded string aliases it
+func BenchmarkGoJayDecodeArrayOfInts(b *testing.B) {
+	var result = make(ints, 0, 1000)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		result = result[:0]
+		gojay.UnmarshalJSONArray(intsFixture, &result)
+	}
+}
+
+func BenchmarkGoJayUnsafeDecodeArrayOfInts(b *testing.B) {
+	var result = make(ints, 0, 1000)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		result = result[:0]
+		gojay.Unsafe.UnmarshalJSONArray(intsFixture, &result)
+	}
+}
+
+// a decoder reading many values compacts its buffer between values
+func BenchmarkGoJayDecodeStreamOfInts(b *testing.B) {
+	var r = bytes.NewReader(nil)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		r.Reset(intsStreamFixture)
+		dec := gojay.BorrowDecoder(r)
+		for i := 0; i < 1000; i++ {
+			var v int
+			if err := dec.DecodeInt(&v); err != nil {
+				b.Fatal(err)
+			}
+		}
+		dec.Release()
+	}
+}
+
+func BenchmarkGoJayDecodeStreamOfObjSmall(b *testing.B) {
+	var r = bytes.NewReader(nil)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		r.Reset(smallStreamFixture)
+		dec := gojay.BorrowDecoder(r)
+		for i := 0; i < 1000; i++ {
+			result := benchmarks.SmallPayload{}
+			// without NKeys, the decoder reads each object up to its closing brace
+			if err := dec.DecodeObject(gojay.DecodeObjectFunc(result.UnmarshalJSONObject)); err != nil {
+				b.Fatal(err)
+			}
+		}
+		dec.Release()
+	}
+}
+
+type intsObject struct {
+	value, sum int
+}
+
+func (o *intsObject) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	if err := dec.Int(&o.value); err != nil {
+		return err
+	}
+	o.sum += o.value
+	return nil
+}
+
+func (o *intsObject) NKeys() int {
+	return 0
+}
+
+var intsObjectFixture = func() []byte {
+	var object = []byte{'{'}
+	for i := 0; i < 100; i++ {
+		if i > 0 {
+			object = append(object, ',')
+		}
+		object = append(strconv.AppendInt(append(object, `"key`...), int64(i), 10), `":`...)
+		object = strconv.AppendInt(object, int64(i), 10)
+	}
+	return append(object, '}')
+}()
+
+// keys are interned rather than aliasing the buffer, the pooled buffer is reused for objects
+func BenchmarkGoJayDecodeObjectOfInts(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		var result intsObject
+		gojay.UnmarshalJSONObject(intsObjectFixture, &result)
+	}
+}
+
+func BenchmarkGoJayDecodeStreamOfObjectOfInts(b *testing.B) {
+	var r = bytes.NewReader(nil)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		r.Reset(intsObjectFixture)
+		dec := gojay.BorrowDecoder(r)
+		var result intsObject
+		if err := dec.DecodeObject(&result); err != nil {
+			b.Fatal(err)
+		}
+		dec.Release()
+	}
+}
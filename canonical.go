@@ -0,0 +1,314 @@
+package gojay
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Canonicalize returns the canonical form of the JSON document data,
+// as defined by the JSON Canonicalization Scheme (RFC 8785).
+//
+// Object members are sorted by the UTF-16 code units of their keys, numbers are formatted
+// as ECMAScript does and strings are escaped minimally, white spaces are removed.
+// The canonical form is byte stable, it can be signed or hashed.
+//
+// data must be I-JSON (RFC 7493): duplicate keys, invalid UTF-8, lone surrogates
+// and numbers overflowing a float64 return an InvalidJSONError.
+//
+// To canonicalize the encoding of a value, canonicalize the output of the Marshal API:
+//	b, err := gojay.MarshalJSONObject(v)
+//	if err == nil {
+//		b, err = gojay.Canonicalize(b)
+//	}
+func Canonicalize(data []byte) ([]byte, error) {
+	dec := borrowDecoder(nil, 0)
+	defer dec.Release()
+	dec.data = data
+	dec.length = len(data)
+	b, err := dec.canonicalValue(make([]byte, 0, len(data)))
+	if err != nil {
+		return nil, err
+	}
+	// only white spaces are allowed after the value
+	if dec.nextNonSpace() != 0 {
+		return nil, dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	return b, nil
+}
+
+// canonicalMember is an object member, its value is encoded in the output buffer at [start:end].
+type canonicalMember struct {
+	key   []byte
+	start int
+	end   int
+}
+
+// canonicalValue appends to b the canonical form of the next value, checking its grammar as skipValue does.
+func (dec *Decoder) canonicalValue(b []byte) ([]byte, error) {
+	switch dec.nextNonSpace() {
+	case '{':
+		dec.cursor++
+		return dec.canonicalObject(b)
+	case '[':
+		dec.cursor++
+		return dec.canonicalArray(b)
+	case '"':
+		dec.cursor++
+		s, err := dec.canonicalString(nil)
+		if err != nil {
+			return b, err
+		}
+		return appendCanonicalString(b, s), nil
+	case 't':
+		dec.cursor++
+		return append(b, "true"...), dec.assertTrue()
+	case 'f':
+		dec.cursor++
+		return append(b, "false"...), dec.assertFalse()
+	case 'n':
+		dec.cursor++
+		return append(b, "null"...), dec.assertNull()
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		start := dec.cursor
+		if _, err := dec.skipNumber(); err != nil {
+			return b, err
+		}
+		f, err := strconv.ParseFloat(string(dec.data[start:dec.cursor]), 64)
+		if err != nil {
+			return b, InvalidJSONError("Invalid JSON, number " + string(dec.data[start:dec.cursor]) + " overflows a float64")
+		}
+		return appendCanonicalNumber(b, f), nil
+	}
+	return b, dec.raiseInvalidJSONErr(dec.cursor)
+}
+
+func (dec *Decoder) canonicalObject(b []byte) ([]byte, error) {
+	var start = len(b)
+	var members []canonicalMember
+	c := dec.nextNonSpace()
+	if c == '}' {
+		dec.cursor++
+		return append(b, '{', '}'), nil
+	}
+	for {
+		if c != '"' {
+			return b, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		dec.cursor++
+		key, err := dec.canonicalString(nil)
+		if err != nil {
+			return b, err
+		}
+		if dec.nextNonSpace() != ':' {
+			return b, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		dec.cursor++
+		var member = canonicalMember{key: key, start: len(b)}
+		if b, err = dec.canonicalValue(b); err != nil {
+			return b, err
+		}
+		member.end = len(b)
+		members = append(members, member)
+		switch dec.nextNonSpace() {
+		case ',':
+			dec.cursor++
+			c = dec.nextNonSpace()
+		case '}':
+			dec.cursor++
+			return appendCanonicalMembers(b, start, members)
+		default:
+			return b, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+}
+
+// appendCanonicalMembers replaces the member values encoded in b after start by the object sorted by keys.
+func appendCanonicalMembers(b []byte, start int, members []canonicalMember) ([]byte, error) {
+	sort.Slice(members, func(i, j int) bool {
+		return lessUTF16(members[i].key, members[j].key)
+	})
+	var object = make([]byte, 0, len(b)-start+len(members)*8)
+	object = append(object, '{')
+	for i, member := range members {
+		if i > 0 {
+			if string(members[i-1].key) == string(member.key) {
+				return b, InvalidJSONError("Invalid JSON, duplicate key " + strconv.Quote(string(member.key)))
+			}
+			object = append(object, ',')
+		}
+		object = appendCanonicalString(object, member.key)
+		object = append(object, ':')
+		object = append(object, b[member.start:member.end]...)
+	}
+	object = append(object, '}')
+	return append(b[:start], object...), nil
+}
+
+func (dec *Decoder) canonicalArray(b []byte) ([]byte, error) {
+	b = append(b, '[')
+	if dec.nextNonSpace() == ']' {
+		dec.cursor++
+		return append(b, ']'), nil
+	}
+	var err error
+	for {
+		if b, err = dec.canonicalValue(b); err != nil {
+			return b, err
+		}
+		switch dec.nextNonSpace() {
+		case ',':
+			dec.cursor++
+			b = append(b, ',')
+		case ']':
+			dec.cursor++
+			return append(b, ']'), nil
+		default:
+			return b, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+}
+
+// canonicalString appends to s the unescaped string starting at the cursor, right after the opening quote.
+func (dec *Decoder) canonicalString(s []byte) ([]byte, error) {
+	start := dec.cursor
+	if err := dec.skipString(); err != nil {
+		return s, err
+	}
+	raw := dec.data[start : dec.cursor-1]
+	for i := 0; i < len(raw); i++ {
+		if raw[i] != '\\' {
+			s = append(s, raw[i])
+			continue
+		}
+		i++
+		switch raw[i] {
+		case 'b':
+			s = append(s, '\b')
+		case 'f':
+			s = append(s, '\f')
+		case 'n':
+			s = append(s, '\n')
+		case 'r':
+			s = append(s, '\r')
+		case 't':
+			s = append(s, '\t')
+		case 'u':
+			r := parseHexRune(raw[i+1 : i+5])
+			i += 4
+			if utf16.IsSurrogate(r) {
+				// a high surrogate must be followed by an escaped low surrogate
+				if i+6 >= len(raw) || raw[i+1] != '\\' || raw[i+2] != 'u' {
+					return s, InvalidJSONError("Invalid JSON, lone surrogate in string")
+				}
+				if r = utf16.DecodeRune(r, parseHexRune(raw[i+3:i+7])); r == utf8.RuneError {
+					return s, InvalidJSONError("Invalid JSON, lone surrogate in string")
+				}
+				i += 6
+			}
+			var rb [utf8.UTFMax]byte
+			s = append(s, rb[:utf8.EncodeRune(rb[:], r)]...)
+		default:
+			// '"', '\\' and '/'
+			s = append(s, raw[i])
+		}
+	}
+	if !utf8.Valid(s) {
+		return s, InvalidJSONError("Invalid JSON, invalid UTF-8 in string")
+	}
+	return s, nil
+}
+
+// parseHexRune parses the 4 hex digits of an escaped unicode char, already validated.
+func parseHexRune(b []byte) rune {
+	var r rune
+	for _, c := range b {
+		switch {
+		case c >= 'a':
+			r = r*16 + rune(c-'a'+10)
+		case c >= 'A':
+			r = r*16 + rune(c-'A'+10)
+		default:
+			r = r*16 + rune(c-'0')
+		}
+	}
+	return r
+}
+
+const lowerHex = "0123456789abcdef"
+
+// appendCanonicalString appends the JSON string s to b, only quotes, backslashes and control chars are escaped.
+func appendCanonicalString(b, s []byte) []byte {
+	b = append(b, '"')
+	for _, c := range s {
+		switch c {
+		case '"', '\\':
+			b = append(b, '\\', c)
+		case '\b':
+			b = append(b, '\\', 'b')
+		case '\f':
+			b = append(b, '\\', 'f')
+		case '\n':
+			b = append(b, '\\', 'n')
+		case '\r':
+			b = append(b, '\\', 'r')
+		case '\t':
+			b = append(b, '\\', 't')
+		default:
+			if c < 0x20 {
+				b = append(b, '\\', 'u', '0', '0', lowerHex[c>>4], lowerHex[c&0xF])
+				continue
+			}
+			b = append(b, c)
+		}
+	}
+	return append(b, '"')
+}
+
+// appendCanonicalNumber appends f to b formatted as ECMAScript Number.prototype.toString does.
+func appendCanonicalNumber(b []byte, f float64) []byte {
+	if f == 0 {
+		// negative zero too
+		return append(b, '0')
+	}
+	abs := math.Abs(f)
+	if abs >= 1e-6 && abs < 1e21 {
+		return strconv.AppendFloat(b, f, 'f', -1, 64)
+	}
+	b = strconv.AppendFloat(b, f, 'e', -1, 64)
+	// the exponent has no leading zero, i.e 1e-7 instead of 1e-07
+	n := len(b)
+	if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+		b[n-2] = b[n-1]
+		b = b[:n-1]
+	}
+	return b
+}
+
+// lessUTF16 reports whether a sorts before b when comparing their UTF-16 code units.
+func lessUTF16(a, b []byte) bool {
+	for len(a) > 0 && len(b) > 0 {
+		ra, na := utf8.DecodeRune(a)
+		rb, nb := utf8.DecodeRune(b)
+		if ra != rb {
+			ha, la := utf16Units(ra)
+			hb, lb := utf16Units(rb)
+			if ha != hb {
+				return ha < hb
+			}
+			return la < lb
+		}
+		a, b = a[na:], b[nb:]
+	}
+	return len(b) > 0
+}
+
+// utf16Units returns the UTF-16 code units of r, the second one is 0 if r is in the basic multilingual plane.
+func utf16Units(r rune) (rune, rune) {
+	if r < 0x10000 {
+		return r, 0
+	}
+	return utf16.EncodeRune(r)
+}
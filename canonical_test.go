@@ -0,0 +1,152 @@
+package gojay
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalize(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		expected string
+	}{
+		{
+			// RFC 8785 section 3.2.2
+			name: "rfc-example",
+			json: `{
+  "numbers": [333333333.33333329, 1E30, 4.50,
+              2e-3, 0.000000000000000000000000001],
+  "string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/",
+  "literals": [null, true, false]
+}`,
+			expected: `{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],"string":"€$\u000f\nA'B\"\\\\\"/"}`,
+		},
+		{
+			// RFC 8785 section 3.2.3
+			name: "rfc-sorting",
+			json: `{
+  "\u20ac": "Euro Sign",
+  "\r": "Carriage Return",
+  "\ufb33": "Hebrew Letter Dalet With Dagesh",
+  "1": "One",
+  "\ud83d\ude00": "Emoji: Grinning Face",
+  "\u0080": "Control",
+  "\u00f6": "Latin Small Letter O With Diaeresis"
+}`,
+			expected: "{\"\\r\":\"Carriage Return\",\"1\":\"One\",\"\u0080\":\"Control\",\"ö\":\"Latin Small Letter O With Diaeresis\",\"€\":\"Euro Sign\",\"😀\":\"Emoji: Grinning Face\",\"\ufb33\":\"Hebrew Letter Dalet With Dagesh\"}",
+		},
+		{
+			name:     "nested",
+			json:     ` { "b" : [ { "d" : 1 , "c" : {} } , [ ] ] , "a" : "" } `,
+			expected: `{"a":"","b":[{"c":{},"d":1},[]]}`,
+		},
+		{
+			name:     "scalar",
+			json:     ` "\u00e9\t" `,
+			expected: "\"\u00e9\\t\"",
+		},
+		{
+			name:     "control-chars",
+			json:     `"\u0000\u001f\b\f<\u2028>"`,
+			expected: "\"\\u0000\\u001f\\b\\f<\u2028>\"",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			b, err := Canonicalize([]byte(testCase.json))
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, string(b), "canonical form should be equal to expected")
+			b, err = Canonicalize(b)
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, string(b), "canonical form should be stable")
+		})
+	}
+}
+
+func TestCanonicalizeNumbers(t *testing.T) {
+	// RFC 8785 appendix B
+	testCases := []struct {
+		bits     uint64
+		expected string
+	}{
+		{bits: 0x0000000000000000, expected: "0"},
+		{bits: 0x8000000000000000, expected: "0"},
+		{bits: 0x0000000000000001, expected: "5e-324"},
+		{bits: 0x8000000000000001, expected: "-5e-324"},
+		{bits: 0x7fefffffffffffff, expected: "1.7976931348623157e+308"},
+		{bits: 0xffefffffffffffff, expected: "-1.7976931348623157e+308"},
+		{bits: 0x4340000000000000, expected: "9007199254740992"},
+		{bits: 0xc340000000000000, expected: "-9007199254740992"},
+		{bits: 0x4430000000000000, expected: "295147905179352830000"},
+		{bits: 0x44b52d02c7e14af5, expected: "9.999999999999997e+22"},
+		{bits: 0x44b52d02c7e14af6, expected: "1e+23"},
+		{bits: 0x44b52d02c7e14af7, expected: "1.0000000000000001e+23"},
+		{bits: 0x444b1ae4d6e2ef4e, expected: "999999999999999700000"},
+		{bits: 0x444b1ae4d6e2ef4f, expected: "999999999999999900000"},
+		{bits: 0x444b1ae4d6e2ef50, expected: "1e+21"},
+		{bits: 0x3eb0c6f7a0b5ed8c, expected: "9.999999999999997e-7"},
+		{bits: 0x3eb0c6f7a0b5ed8d, expected: "0.000001"},
+		{bits: 0x41b3de4355555553, expected: "333333333.3333332"},
+		{bits: 0x41b3de4355555554, expected: "333333333.33333325"},
+		{bits: 0x41b3de4355555555, expected: "333333333.3333333"},
+		{bits: 0x41b3de4355555556, expected: "333333333.3333334"},
+		{bits: 0x41b3de4355555557, expected: "333333333.33333343"},
+		{bits: 0xbecbf647612f3696, expected: "-0.0000033333333333333333"},
+		{bits: 0x43143ff3c1cb0959, expected: "1424953923781206.2"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.expected, func(t *testing.T) {
+			f := math.Float64frombits(testCase.bits)
+			assert.Equal(t, testCase.expected, string(appendCanonicalNumber(nil, f)), "number should be formatted as ECMAScript does")
+			b, err := Canonicalize([]byte(strconv.FormatFloat(f, 'g', -1, 64)))
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, string(b), "number should be canonicalized")
+		})
+	}
+}
+
+func TestCanonicalizeErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		json string
+	}{
+		{name: "duplicate-key", json: `{"a":1,"b":2,"a":3}`},
+		{name: "duplicate-escaped-key", json: `{"a":1,"\u0061":2}`},
+		{name: "lone-high-surrogate", json: `"\ud83d"`},
+		{name: "lone-low-surrogate", json: `"\ude00\ud83d"`},
+		{name: "invalid-utf8", json: "\"\xff\""},
+		{name: "overflow", json: `1e400`},
+		{name: "trailing-comma", json: `[1,]`},
+		{name: "leading-zero", json: `01`},
+		{name: "trailing-data", json: `{} {}`},
+		{name: "unterminated", json: `{"a":[1`},
+		{name: "control-char", json: "\"\n\""},
+		{name: "empty", json: ``},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Canonicalize([]byte(testCase.json))
+			assert.NotNil(t, err, "err should not be nil")
+			assert.IsType(t, InvalidJSONError(""), err, "err should be an InvalidJSONError")
+		})
+	}
+}
+
+func TestCanonicalizeMarshal(t *testing.T) {
+	b, err := MarshalJSONObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.Float64Key("z", 1e21)
+		enc.StringKey("a", "<é>")
+		enc.ArrayKey("m", EncodeArrayFunc(func(enc *Encoder) {
+			enc.Float64(0.1)
+			enc.Int(-0)
+		}))
+	}))
+	assert.Nil(t, err, "err should be nil")
+	b, err = Canonicalize(b)
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"a":"<é>","m":[0.1,0],"z":1e+21}`, string(b), "encoded object should be canonicalized")
+}
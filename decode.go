@@ -14,9 +14,7 @@ import (
 func UnmarshalJSONArray(data []byte, v UnmarshalerJSONArray) error {
 	dec := borrowDecoder(nil, 0)
 	defer dec.Release()
-	dec.data = make([]byte, len(data))
-	copy(dec.data, data)
-	dec.length = len(data)
+	dec.copyData(data)
 	_, err := dec.decodeArray(v)
 	if err != nil {
 		return err
@@ -36,9 +34,7 @@ func UnmarshalJSONArray(data []byte, v UnmarshalerJSONArray) error {
 func UnmarshalJSONObject(data []byte, v UnmarshalerJSONObject) error {
 	dec := borrowDecoder(nil, 0)
 	defer dec.Release()
-	dec.data = make([]byte, len(data))
-	copy(dec.data, data)
-	dec.length = len(data)
+	dec.copyData(data)
 	_, err := dec.decodeObject(v)
 	if err != nil {
 		return err
@@ -196,21 +192,15 @@ func Unmarshal(data []byte, v interface{}) error {
 		err = dec.decodeBoolNull(vt)
 	case UnmarshalerJSONObject:
 		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = make([]byte, len(data))
-		copy(dec.data, data)
+		dec.copyData(data)
 		_, err = dec.decodeObject(vt)
 	case UnmarshalerJSONArray:
 		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = make([]byte, len(data))
-		copy(dec.data, data)
+		dec.copyData(data)
 		_, err = dec.decodeArray(vt)
 	case *interface{}:
 		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = make([]byte, len(data))
-		copy(dec.data, data)
+		dec.copyData(data)
 		err = dec.decodeInterface(vt)
 	default:
 		return InvalidUnmarshalError(fmt.Sprintf(invalidUnmarshalErrorMsg, vt))
@@ -245,6 +235,12 @@ type Decoder struct {
 	length     int
 	keysDone   int
 	arrayIndex int
+	buffer     *[]byte           // box of the pooled buffer, if any
+	owned      byte              // data is a buffer of the decoder, not the caller's
+	aliased    byte              // decoded strings alias data
+	keys       map[string]string // interned keys, kept across borrows
+
+	quotedIntegers bool
 }
 
 // Decode reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the value pointed to by v.
@@ -257,6 +253,7 @@ func (dec *Decoder) Decode(v interface{}) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	var err error
 	switch vt := v.(type) {
 	case *string:
@@ -347,9 +344,15 @@ func (dec *Decoder) read() bool {
 			if nLen == 0 {
 				nLen = 512
 			}
-			Buf := make([]byte, nLen, nLen)
-			copy(Buf, dec.data)
-			dec.data = Buf
+			// the buffer is put back in the pools once copied, unless decoded strings alias it
+			var data, buffer, owned = dec.data, dec.buffer, dec.owned
+			dec.data, dec.buffer = getDecodeBuffer(nLen)
+			copy(dec.data, data[:dec.length])
+			if owned != 0 && dec.aliased == 0 {
+				putDecodeBuffer(data, buffer)
+			}
+			dec.owned = 1
+			dec.aliased = 0
 		}
 		var n int
 		var err error
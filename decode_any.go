@@ -0,0 +1,73 @@
+package gojay
+
+import "fmt"
+
+// decodeAny decodes the next JSON value to the value pointed by v.
+//
+// v can be any pointer accepted by Decode, it is meant for generic code where the type of v is only known at run time.
+// If v is not supported, an InvalidUnmarshalError is returned.
+func (dec *Decoder) decodeAny(v interface{}) error {
+	switch vt := v.(type) {
+	case *string:
+		return dec.String(vt)
+	case **string:
+		return dec.StringNull(vt)
+	case *int:
+		return dec.Int(vt)
+	case **int:
+		return dec.IntNull(vt)
+	case *int8:
+		return dec.Int8(vt)
+	case **int8:
+		return dec.Int8Null(vt)
+	case *int16:
+		return dec.Int16(vt)
+	case **int16:
+		return dec.Int16Null(vt)
+	case *int32:
+		return dec.Int32(vt)
+	case **int32:
+		return dec.Int32Null(vt)
+	case *int64:
+		return dec.Int64(vt)
+	case **int64:
+		return dec.Int64Null(vt)
+	case *uint8:
+		return dec.Uint8(vt)
+	case **uint8:
+		return dec.Uint8Null(vt)
+	case *uint16:
+		return dec.Uint16(vt)
+	case **uint16:
+		return dec.Uint16Null(vt)
+	case *uint32:
+		return dec.Uint32(vt)
+	case **uint32:
+		return dec.Uint32Null(vt)
+	case *uint64:
+		return dec.Uint64(vt)
+	case **uint64:
+		return dec.Uint64Null(vt)
+	case *float64:
+		return dec.Float64(vt)
+	case **float64:
+		return dec.Float64Null(vt)
+	case *float32:
+		return dec.Float32(vt)
+	case **float32:
+		return dec.Float32Null(vt)
+	case *bool:
+		return dec.Bool(vt)
+	case **bool:
+		return dec.BoolNull(vt)
+	case UnmarshalerJSONObject:
+		return dec.Object(vt)
+	case UnmarshalerJSONArray:
+		return dec.Array(vt)
+	case *EmbeddedJSON:
+		return dec.EmbeddedJSON(vt)
+	case *interface{}:
+		return dec.Interface(vt)
+	}
+	return InvalidUnmarshalError(fmt.Sprintf(invalidUnmarshalErrorMsg, v))
+}
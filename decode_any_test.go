@@ -0,0 +1,134 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testAnyObject struct {
+	name  string
+	value interface{}
+}
+
+func (o *testAnyObject) UnmarshalJSONObject(dec *Decoder, key string) error {
+	switch key {
+	case "name":
+		return dec.decodeAny(&o.name)
+	case "value":
+		return dec.decodeAny(o.value)
+	}
+	return nil
+}
+
+func (o *testAnyObject) NKeys() int {
+	return 2
+}
+
+func TestDecoderAny(t *testing.T) {
+	var s string
+	var ps *string
+	var i int
+	var i8 int8
+	var i16 int16
+	var i32 int32
+	var i64 int64
+	var pi64 *int64
+	var u8 uint8
+	var u16 uint16
+	var u32 uint32
+	var u64 uint64
+	var f32 float32
+	var f64 float64
+	var b bool
+	var pb *bool
+	var ints testSliceInts
+	var embedded EmbeddedJSON
+	var iface interface{}
+	testCases := []struct {
+		name     string
+		json     string
+		value    interface{}
+		expected interface{}
+	}{
+		{name: "string", json: `"foo"`, value: &s, expected: "foo"},
+		{name: "string-null", json: `"foo"`, value: &ps, expected: "foo"},
+		{name: "int", json: `1`, value: &i, expected: 1},
+		{name: "int8", json: `2`, value: &i8, expected: int8(2)},
+		{name: "int16", json: `3`, value: &i16, expected: int16(3)},
+		{name: "int32", json: `4`, value: &i32, expected: int32(4)},
+		{name: "int64", json: `5`, value: &i64, expected: int64(5)},
+		{name: "int64-null", json: `6`, value: &pi64, expected: int64(6)},
+		{name: "uint8", json: `7`, value: &u8, expected: uint8(7)},
+		{name: "uint16", json: `8`, value: &u16, expected: uint16(8)},
+		{name: "uint32", json: `9`, value: &u32, expected: uint32(9)},
+		{name: "uint64", json: `10`, value: &u64, expected: uint64(10)},
+		{name: "float32", json: `1.5`, value: &f32, expected: float32(1.5)},
+		{name: "float64", json: `2.5`, value: &f64, expected: 2.5},
+		{name: "bool", json: `true`, value: &b, expected: true},
+		{name: "bool-null", json: `true`, value: &pb, expected: true},
+		{name: "array", json: `[1,2]`, value: &ints, expected: testSliceInts{1, 2}},
+		{name: "embedded-json", json: `{"a":1}`, value: &embedded, expected: EmbeddedJSON(`{"a":1}`)},
+		{name: "interface", json: `"bar"`, value: &iface, expected: "bar"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dec := NewDecoder(strings.NewReader(testCase.json))
+			err := dec.decodeAny(testCase.value)
+			assert.Nil(t, err, "err should be nil")
+			var actual interface{}
+			switch v := testCase.value.(type) {
+			case **string:
+				actual = **v
+			case **int64:
+				actual = **v
+			case **bool:
+				actual = **v
+			case *testSliceInts:
+				actual = *v
+			default:
+				actual = anyValueOf(v)
+			}
+			assert.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func anyValueOf(v interface{}) interface{} {
+	switch vt := v.(type) {
+	case *int16:
+		return *vt
+	case *EmbeddedJSON:
+		return *vt
+	case *interface{}:
+		return *vt
+	}
+	value, _ := anyValue(v)
+	return value
+}
+
+func TestDecoderAnyObject(t *testing.T) {
+	var count int
+	obj := &testAnyObject{value: &count}
+	err := UnmarshalJSONObject([]byte(`{"name":"foo","value":3}`), obj)
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, "foo", obj.name)
+	assert.Equal(t, 3, count)
+}
+
+func TestDecoderAnyNull(t *testing.T) {
+	var ps *string
+	dec := NewDecoder(strings.NewReader(`null`))
+	err := dec.decodeAny(&ps)
+	assert.Nil(t, err, "err should be nil")
+	assert.Nil(t, ps)
+}
+
+func TestDecoderAnyInvalidType(t *testing.T) {
+	var s struct{}
+	dec := NewDecoder(strings.NewReader(`{}`))
+	err := dec.decodeAny(&s)
+	assert.NotNil(t, err, "err should not be nil")
+	assert.IsType(t, InvalidUnmarshalError(""), err)
+}
@@ -12,6 +12,7 @@ func (dec *Decoder) DecodeArray(v UnmarshalerJSONArray) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	_, err := dec.decodeArray(v)
 	return err
 }
@@ -136,57 +137,27 @@ func (dec *Decoder) decodeArrayNull(v interface{}) (int, error) {
 	return 0, dec.raiseInvalidJSONErr(dec.cursor)
 }
 
+// skipArray skips an array, the cursor must be right after its opening bracket.
+// It strictly checks the grammar of the array and returns the cursor after its closing bracket.
 func (dec *Decoder) skipArray() (int, error) {
-	var arraysOpen = 1
-	var arraysClosed = 0
-	// var stringOpen byte = 0
-	for j := dec.cursor; j < dec.length || dec.read(); j++ {
-		switch dec.data[j] {
+	if dec.nextNonSpace() == ']' {
+		dec.cursor++
+		return dec.cursor, nil
+	}
+	for {
+		if err := dec.skipValue(); err != nil {
+			return 0, err
+		}
+		switch dec.nextNonSpace() {
+		case ',':
+			dec.cursor++
 		case ']':
-			arraysClosed++
-			// everything is closed return
-			if arraysOpen == arraysClosed {
-				// add char to object data
-				return j + 1, nil
-			}
-		case '[':
-			arraysOpen++
-		case '"':
-			j++
-			var isInEscapeSeq bool
-			var isFirstQuote = true
-			for ; j < dec.length || dec.read(); j++ {
-				if dec.data[j] != '"' {
-					continue
-				}
-				if dec.data[j-1] != '\\' || (!isInEscapeSeq && !isFirstQuote) {
-					break
-				} else {
-					isInEscapeSeq = false
-				}
-				if isFirstQuote {
-					isFirstQuote = false
-				}
-				// loop backward and count how many anti slash found
-				// to see if string is effectively escaped
-				ct := 0
-				for i := j - 1; i > 0; i-- {
-					if dec.data[i] != '\\' {
-						break
-					}
-					ct++
-				}
-				// is pair number of slashes, quote is not escaped
-				if ct&1 == 0 {
-					break
-				}
-				isInEscapeSeq = true
-			}
+			dec.cursor++
+			return dec.cursor, nil
 		default:
-			continue
+			return 0, dec.raiseInvalidJSONErr(dec.cursor)
 		}
 	}
-	return 0, dec.raiseInvalidJSONErr(dec.cursor)
 }
 
 // DecodeArrayFunc is a func type implementing UnmarshalerJSONArray.
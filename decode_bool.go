@@ -8,6 +8,7 @@ func (dec *Decoder) DecodeBool(v *bool) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeBool(v)
 }
 func (dec *Decoder) decodeBool(v *bool) error {
@@ -114,7 +115,7 @@ func (dec *Decoder) assertTrue() error {
 			}
 		case 3:
 			switch dec.data[dec.cursor] {
-			case ' ', '\b', '\t', '\n', ',', ']', '}':
+			case ' ', '\b', '\t', '\n', '\r', ',', ']', '}':
 				// dec.cursor--
 				return nil
 			default:
@@ -147,7 +148,7 @@ func (dec *Decoder) assertNull() error {
 			}
 		case 3:
 			switch dec.data[dec.cursor] {
-			case ' ', '\t', '\n', ',', ']', '}':
+			case ' ', '\t', '\n', '\r', ',', ']', '}':
 				// dec.cursor--
 				return nil
 			default:
@@ -184,7 +185,7 @@ func (dec *Decoder) assertFalse() error {
 			}
 		case 4:
 			switch dec.data[dec.cursor] {
-			case ' ', '\t', '\n', ',', ']', '}':
+			case ' ', '\t', '\n', '\r', ',', ']', '}':
 				// dec.cursor--
 				return nil
 			default:
@@ -0,0 +1,120 @@
+package gojay
+
+import "sync"
+
+// Keys are copied rather than aliasing the buffer, as the buffer of every object would not be reused otherwise.
+// Up to maxInternedKeys keys of at most maxInternedKeyLen bytes are interned by each decoder,
+// so that decoding objects with known keys does not allocate.
+const (
+	maxInternedKeys   = 512
+	maxInternedKeyLen = 64
+)
+
+// Decoder buffers are retained across borrows in pools by size class, from 512B to 64KB.
+// Larger buffers are left to the garbage collector.
+const (
+	minDecodeBufferSize     = 512
+	decodeBufferClasses     = 8
+	maxRetainedDecodeBuffer = minDecodeBufferSize << (decodeBufferClasses - 1)
+)
+
+// decodeBufferPools hold boxed buffers, a box is reused when its buffer is put back.
+var decodeBufferPools [decodeBufferClasses]sync.Pool
+
+// getDecodeBuffer returns a buffer of at least size bytes, and the box it was pooled in or nil.
+// Buffers smaller than the smallest size class are allocated to size, they are never retained.
+func getDecodeBuffer(size int) ([]byte, *[]byte) {
+	if size < minDecodeBufferSize {
+		return make([]byte, size), nil
+	}
+	var class int
+	for class < decodeBufferClasses && minDecodeBufferSize<<uint(class) < size {
+		class++
+	}
+	if class == decodeBufferClasses {
+		return make([]byte, size), nil
+	}
+	if box, ok := decodeBufferPools[class].Get().(*[]byte); ok {
+		return (*box)[:cap(*box)], box
+	}
+	return make([]byte, minDecodeBufferSize<<uint(class)), nil
+}
+
+// putDecodeBuffer puts back b in the pool of its size class, in box or in a new box if box is nil.
+func putDecodeBuffer(b []byte, box *[]byte) {
+	size := cap(b)
+	if size < minDecodeBufferSize || size > maxRetainedDecodeBuffer {
+		return
+	}
+	var class = decodeBufferClasses - 1
+	for minDecodeBufferSize<<uint(class) > size {
+		class--
+	}
+	if box == nil {
+		box = new([]byte)
+	}
+	*box = b[:0]
+	decodeBufferPools[class].Put(box)
+}
+
+// borrowBuffer sets the decoder buffer to a pooled buffer of at least size bytes and returns it.
+func (dec *Decoder) borrowBuffer(size int) []byte {
+	dec.releaseBuffer()
+	dec.data, dec.buffer = getDecodeBuffer(size)
+	dec.owned = 1
+	return dec.data
+}
+
+// releaseBuffer puts back the decoder buffer in the pools.
+// Decoded strings alias the buffer, a buffer they alias is left to the garbage collector.
+func (dec *Decoder) releaseBuffer() {
+	if dec.owned != 0 && dec.aliased == 0 {
+		putDecodeBuffer(dec.data, dec.buffer)
+	}
+	dec.buffer = nil
+	dec.owned = 0
+	dec.aliased = 0
+}
+
+// copyData sets the decoder buffer to a pooled copy of data.
+func (dec *Decoder) copyData(data []byte) {
+	dec.data = dec.borrowBuffer(len(data))[:len(data)]
+	copy(dec.data, data)
+	dec.length = len(data)
+}
+
+// key returns a copy of the key b, interned if it is short enough.
+func (dec *Decoder) key(b []byte) string {
+	if k, ok := dec.keys[string(b)]; ok {
+		return k
+	}
+	k := string(b)
+	if len(k) <= maxInternedKeyLen {
+		if dec.keys == nil || len(dec.keys) == maxInternedKeys {
+			dec.keys = make(map[string]string)
+		}
+		dec.keys[k] = k
+	}
+	return k
+}
+
+// compact drops the decoded bytes from the buffer of a decoder reading from an io.Reader,
+// so that decoding a stream of values does not grow the buffer past the size of a few values.
+// It runs between values only, when no decoding function holds an offset in the buffer.
+// The bytes left are moved to the start of the buffer, or to a new buffer if decoded strings alias it.
+func (dec *Decoder) compact() {
+	if dec.r == nil || dec.cursor == 0 || dec.cursor < len(dec.data)/2 {
+		return
+	}
+	var data = dec.data
+	if dec.aliased != 0 {
+		// the aliased buffer is not reused, the strings keep it alive,
+		// a new buffer is borrowed once most of the bytes read are decoded
+		if dec.length-dec.cursor > len(data)/4 {
+			return
+		}
+		dec.borrowBuffer(len(data))
+	}
+	dec.length = copy(dec.data, data[dec.cursor:dec.length])
+	dec.cursor = 0
+}
@@ -0,0 +1,174 @@
+package gojay
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeBufferPools(t *testing.T) {
+	testCases := []struct {
+		name     string
+		size     int
+		expected int
+	}{
+		{name: "zero", size: 0, expected: 0},
+		{name: "small", size: 100, expected: 100},
+		{name: "min", size: 512, expected: 512},
+		{name: "class", size: 513, expected: 1024},
+		{name: "max", size: maxRetainedDecodeBuffer, expected: maxRetainedDecodeBuffer},
+		{name: "larger", size: maxRetainedDecodeBuffer + 1, expected: maxRetainedDecodeBuffer + 1},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			b, box := getDecodeBuffer(testCase.size)
+			assert.Len(t, b, testCase.expected, "buffer should be of the size class")
+			putDecodeBuffer(b, box)
+		})
+	}
+	t.Run("put", func(t *testing.T) {
+		var box = new([]byte)
+		putDecodeBuffer(make([]byte, 700), box)
+		assert.Equal(t, 700, cap(*box), "buffer should be put back in its box")
+		assert.Len(t, *box, 0, "buffer put back should be emptied")
+		box = new([]byte)
+		putDecodeBuffer(make([]byte, maxRetainedDecodeBuffer+1), box)
+		assert.Nil(t, *box, "buffer larger than the max retained size should be left")
+	})
+}
+
+func TestDecoderReleaseBuffer(t *testing.T) {
+	t.Run("not-aliased", func(t *testing.T) {
+		dec := BorrowDecoder(strings.NewReader(`[1,2,3]`))
+		var v = testSliceInts{}
+		err := dec.DecodeArray(&v)
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, byte(1), dec.owned, "decoder should own its buffer")
+		assert.Equal(t, byte(0), dec.aliased, "decoding ints should not alias the buffer")
+		dec.Release()
+		assert.Equal(t, byte(0), dec.owned, "buffer should be released")
+		assert.Nil(t, dec.data, "data should be released")
+	})
+	t.Run("object-keys", func(t *testing.T) {
+		var maps = make([]map[string]int, 100)
+		for i := range maps {
+			var m = map[string]int{}
+			dec := BorrowDecoder(strings.NewReader(`{"key` + strconv.Itoa(i) + `":` + strconv.Itoa(i) + `}`))
+			err := dec.DecodeObject(DecodeObjectFunc(func(dec *Decoder, k string) error {
+				var v int
+				err := dec.Int(&v)
+				m[k] = v
+				return err
+			}))
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, byte(0), dec.aliased, "decoding keys should not alias the buffer")
+			dec.Release()
+			maps[i] = m
+		}
+		for i, m := range maps {
+			_, ok := m["key"+strconv.Itoa(i)]
+			assert.True(t, ok, "kept keys should not be overwritten by later decodings")
+		}
+	})
+	t.Run("aliased", func(t *testing.T) {
+		var values = make([]testObject, 100)
+		for i := range values {
+			err := UnmarshalJSONObject([]byte(`{"testStr":"string`+strconv.Itoa(i)+`"}`), &values[i])
+			assert.Nil(t, err, "err should be nil")
+		}
+		for i, v := range values {
+			assert.Equal(t, "string"+strconv.Itoa(i), v.testStr, "decoded strings should not be overwritten by later decodings")
+		}
+	})
+}
+
+func TestDecoderCompact(t *testing.T) {
+	var input = strings.Builder{}
+	for i := 0; i < 10000; i++ {
+		input.WriteString(`"string` + strconv.Itoa(i) + `" `)
+	}
+	t.Run("strings", func(t *testing.T) {
+		dec := NewDecoder(strings.NewReader(input.String()))
+		var values = make([]string, 10000)
+		for i := range values {
+			err := dec.DecodeString(&values[i])
+			assert.Nil(t, err, "err should be nil")
+			assert.True(t, len(dec.data) <= 1024, "buffer should not grow with the stream")
+		}
+		for i, v := range values {
+			assert.Equal(t, "string"+strconv.Itoa(i), v, "decoded strings should not be overwritten by compaction")
+		}
+	})
+	t.Run("ints", func(t *testing.T) {
+		var input = strings.Builder{}
+		for i := 0; i < 10000; i++ {
+			input.WriteString(strconv.Itoa(i) + ` `)
+		}
+		dec := NewDecoder(strings.NewReader(input.String()))
+		for i := 0; i < 10000; i++ {
+			var v int
+			err := dec.DecodeInt(&v)
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, i, v, "v should be equal to the decoded int")
+			assert.True(t, len(dec.data) <= 1024, "buffer should not grow with the stream")
+		}
+		assert.Equal(t, byte(0), dec.aliased, "decoding ints should not alias the buffer")
+	})
+}
+
+func TestDecoderErrorStaleBuffer(t *testing.T) {
+	// decoding a long document leaves its bytes in the pooled buffers
+	var long = `[` + strings.Repeat(`12345678, `, 200) + `1]`
+	var truncated = `[` + strings.Repeat(`1, `, 500)
+	testCases := []struct {
+		name  string
+		json  string
+		valid func(json string) error
+	}{
+		{name: "validate-number", json: `1.`, valid: func(json string) error { return Validate(strings.NewReader(json)) }},
+		{name: "validate-string", json: `"abc`, valid: func(json string) error { return Validate(strings.NewReader(json)) }},
+		{name: "validate-empty", json: ``, valid: func(json string) error { return Validate(strings.NewReader(json)) }},
+		{name: "validate-array", json: `[1,`, valid: func(json string) error { return Validate(strings.NewReader(json)) }},
+		{name: "decode-object", json: `{"abc"`, valid: func(json string) error {
+			return BorrowDecoder(strings.NewReader(json)).DecodeObject(&testObject{})
+		}},
+		{name: "unmarshal-array", json: truncated, valid: func(json string) error {
+			var v testSliceInts
+			return UnmarshalJSONArray([]byte(json), &v)
+		}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				var v interface{}
+				assert.Nil(t, UnmarshalJSONArray([]byte(long), &testSliceInts{}), "err should be nil")
+				assert.Nil(t, NewDecoder(strings.NewReader(long)).Decode(&v), "err should be nil")
+			}
+			err := testCase.valid(testCase.json)
+			assert.IsType(t, InvalidJSONError(""), err, "err should be an InvalidJSONError")
+			assert.Equal(t, fmt.Sprintf(invalidJSONCharErrorMsg, 0, len(testCase.json)), err.Error(), "err should not report a stale byte")
+		})
+	}
+}
+
+func TestDecoderKey(t *testing.T) {
+	dec := NewDecoder(nil)
+	var b = []byte("key")
+	k := dec.key(b)
+	b[0] = 'x'
+	assert.Equal(t, "key", k, "key should not alias the bytes")
+	allocs := testing.AllocsPerRun(100, func() {
+		dec.key([]byte("key"))
+	})
+	assert.Equal(t, 0.0, allocs, "interned key should not allocate")
+
+	dec.key([]byte(strings.Repeat("k", maxInternedKeyLen+1)))
+	assert.Len(t, dec.keys, 1, "long key should not be interned")
+	for i := 0; i < maxInternedKeys; i++ {
+		dec.key([]byte(strconv.Itoa(i)))
+	}
+	assert.True(t, len(dec.keys) <= maxInternedKeys, "interned keys should be bounded")
+}
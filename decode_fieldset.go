@@ -0,0 +1,24 @@
+package gojay
+
+import "math/bits"
+
+// FieldSet is a set of up to 64 fields of an object, identified by their index.
+//
+// The code generator records in a FieldSet field of a struct the fields present in the decoded JSON,
+// so that an absent field can be told from a field set to its zero value.
+type FieldSet uint64
+
+// Has returns true if the field at index i is in the set.
+func (s FieldSet) Has(i int) bool {
+	return s&(1<<uint(i)) != 0
+}
+
+// Set adds the field at index i to the set.
+func (s *FieldSet) Set(i int) {
+	*s |= 1 << uint(i)
+}
+
+// Len returns the number of fields in the set.
+func (s FieldSet) Len() int {
+	return bits.OnesCount64(uint64(s))
+}
@@ -0,0 +1,102 @@
+package gojay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testPresenceObject decodes two keys and records them in a FieldSet as generated decoders do
+type testPresenceObject struct {
+	a      string
+	b      string
+	fields FieldSet
+}
+
+func (o *testPresenceObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "a":
+		if o.fields.Has(0) {
+			defer dec.DuplicateKey()
+		}
+		o.fields.Set(0)
+		return dec.String(&o.a)
+	case "b":
+		if o.fields.Has(1) {
+			defer dec.DuplicateKey()
+		}
+		o.fields.Set(1)
+		return dec.String(&o.b)
+	}
+	return nil
+}
+
+func (o *testPresenceObject) NKeys() int {
+	return 2
+}
+
+func TestFieldSet(t *testing.T) {
+	var s FieldSet
+	assert.False(t, s.Has(0), "empty set should not have field 0")
+	assert.Equal(t, 0, s.Len())
+	s.Set(0)
+	s.Set(63)
+	s.Set(63)
+	assert.True(t, s.Has(0), "set should have field 0")
+	assert.True(t, s.Has(63), "set should have field 63")
+	assert.False(t, s.Has(1), "set should not have field 1")
+	assert.Equal(t, 2, s.Len())
+}
+
+func TestDecoderDuplicateKey(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		expected testPresenceObject
+	}{
+		{
+			name:     "no-duplicate",
+			json:     `{"a":"x","b":"y","c":"z"}`,
+			expected: testPresenceObject{a: "x", b: "y", fields: 3},
+		},
+		{
+			name:     "duplicate-before-last-key",
+			json:     `{"a":"x","a":"x2","b":"y"}`,
+			expected: testPresenceObject{a: "x2", b: "y", fields: 3},
+		},
+		{
+			name:     "duplicate-object-value",
+			json:     `{"a":"x","c":{"a":"w"},"a":"x2","b":"y"}`,
+			expected: testPresenceObject{a: "x2", b: "y", fields: 3},
+		},
+		{
+			name:     "absent-key",
+			json:     `{"b":"y","b":""}`,
+			expected: testPresenceObject{b: "", fields: 2},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var v = &testPresenceObject{}
+			err := UnmarshalJSONObject([]byte(testCase.json), v)
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, *v)
+		})
+	}
+	t.Run("nested", func(t *testing.T) {
+		var v = &testPresenceObject{}
+		var after string
+		err := UnmarshalJSONObject([]byte(`{"v":{"a":"x","a":"x2","b":"y"},"after":"z"}`), DecodeObjectFunc(func(dec *Decoder, k string) error {
+			switch k {
+			case "v":
+				return dec.Object(v)
+			case "after":
+				return dec.String(&after)
+			}
+			return nil
+		}))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, testPresenceObject{a: "x2", b: "y", fields: 3}, *v)
+		assert.Equal(t, "z", after)
+	})
+}
@@ -0,0 +1,50 @@
+//go:build go1.18
+
+package gojay
+
+// UnmarshalJSONArray implements UnmarshalerJSONArray, the elements are appended to s.
+func (s *Slice[T]) UnmarshalJSONArray(dec *Decoder) error {
+	var v T
+	if err := decodeValue(dec, &v); err != nil {
+		return err
+	}
+	*s = append(*s, v)
+	return nil
+}
+
+// UnmarshalJSONObject implements UnmarshalerJSONObject, the map is made if nil.
+func (m *Map[V]) UnmarshalJSONObject(dec *Decoder, key string) error {
+	var v V
+	if err := decodeValue(dec, &v); err != nil {
+		return err
+	}
+	if *m == nil {
+		*m = make(Map[V])
+	}
+	(*m)[key] = v
+	return nil
+}
+
+// NKeys implements UnmarshalerJSONObject, all keys are decoded.
+func (m *Map[V]) NKeys() int {
+	return 0
+}
+
+// decodeValue decodes the next value to v.
+// T is decoded as a builtin type, or as an object or an array if *T implements UnmarshalerJSONObject or UnmarshalerJSONArray.
+// If T is a pointer to such a type, a new value is allocated unless the JSON value is null.
+func decodeValue[T any](dec *Decoder, v *T) error {
+	switch vt := any(v).(type) {
+	case UnmarshalerJSONObject:
+		return dec.Object(vt)
+	case UnmarshalerJSONArray:
+		return dec.Array(vt)
+	}
+	switch any(*v).(type) {
+	case UnmarshalerJSONObject:
+		return dec.ObjectNull(v)
+	case UnmarshalerJSONArray:
+		return dec.ArrayNull(v)
+	}
+	return dec.decodeAny(v)
+}
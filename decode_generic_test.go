@@ -0,0 +1,126 @@
+//go:build go1.18
+
+package gojay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSlice(t *testing.T) {
+	t.Run("ints", func(t *testing.T) {
+		var s Slice[int]
+		err := UnmarshalJSONArray([]byte(`[1,-2,3]`), &s)
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, Slice[int]{1, -2, 3}, s)
+	})
+	t.Run("strings", func(t *testing.T) {
+		var s []string
+		err := UnmarshalJSONArray([]byte(`["a","\"b\""]`), SliceOf(&s))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, []string{"a", `"b"`}, s)
+	})
+	t.Run("values", func(t *testing.T) {
+		var users []testGenericUser
+		err := UnmarshalJSONArray([]byte(`[{"id":1,"name":"a"},{"id":2,"name":"b"}]`), SliceOf(&users))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, []testGenericUser{{id: 1, name: "a"}, {id: 2, name: "b"}}, users)
+	})
+	t.Run("pointers", func(t *testing.T) {
+		var users []*testGenericUser
+		err := UnmarshalJSONArray([]byte(`[{"id":1,"name":"a"},null]`), SliceOf(&users))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, []*testGenericUser{{id: 1, name: "a"}, nil}, users)
+	})
+	t.Run("pointers-to-builtins", func(t *testing.T) {
+		var s []*float64
+		err := UnmarshalJSONArray([]byte(`[1.5,null]`), SliceOf(&s))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, []*float64{Ptr(1.5), nil}, s)
+	})
+	t.Run("nested", func(t *testing.T) {
+		var s Slice[Slice[int]]
+		err := UnmarshalJSONArray([]byte(`[[1,2],[],[3]]`), &s)
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, Slice[Slice[int]]{{1, 2}, nil, {3}}, s)
+	})
+	t.Run("appends", func(t *testing.T) {
+		s := []int{1}
+		dec := BorrowDecoder(nil)
+		defer dec.Release()
+		dec.data = []byte(`[2,3]`)
+		dec.length = len(dec.data)
+		err := dec.Array(SliceOf(&s))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, []int{1, 2, 3}, s)
+	})
+	t.Run("invalid-type", func(t *testing.T) {
+		var s Slice[int]
+		err := UnmarshalJSONArray([]byte(`[1,"a"]`), &s)
+		assert.NotNil(t, err, "err should not be nil")
+		assert.IsType(t, InvalidUnmarshalError(""), err, "err should be an InvalidUnmarshalError")
+	})
+	t.Run("unsupported-type", func(t *testing.T) {
+		var s Slice[struct{}]
+		err := UnmarshalJSONArray([]byte(`[{}]`), &s)
+		assert.NotNil(t, err, "err should not be nil")
+		assert.IsType(t, InvalidUnmarshalError(""), err, "err should be an InvalidUnmarshalError")
+	})
+	t.Run("invalid-json", func(t *testing.T) {
+		var s Slice[int]
+		err := UnmarshalJSONArray([]byte(`[1,`), &s)
+		assert.NotNil(t, err, "err should not be nil")
+		assert.IsType(t, InvalidJSONError(""), err, "err should be an InvalidJSONError")
+	})
+}
+
+func TestDecodeMap(t *testing.T) {
+	t.Run("ints", func(t *testing.T) {
+		var m map[string]int
+		err := UnmarshalJSONObject([]byte(`{"a":1,"b":2}`), MapOf(&m))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, m)
+	})
+	t.Run("values", func(t *testing.T) {
+		var m Map[testGenericUser]
+		err := UnmarshalJSONObject([]byte(`{"u":{"id":1,"name":"a"}}`), &m)
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, Map[testGenericUser]{"u": {id: 1, name: "a"}}, m)
+	})
+	t.Run("slices", func(t *testing.T) {
+		var m Map[Slice[string]]
+		err := UnmarshalJSONObject([]byte(`{"tags":["a","b"],"none":[]}`), &m)
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, Map[Slice[string]]{"tags": {"a", "b"}, "none": nil}, m)
+	})
+	t.Run("interfaces", func(t *testing.T) {
+		var m Map[interface{}]
+		err := UnmarshalJSONObject([]byte(`{"a":1,"b":"x","c":null}`), &m)
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, Map[interface{}]{"a": float64(1), "b": "x", "c": nil}, m)
+	})
+	t.Run("existing", func(t *testing.T) {
+		m := map[string]bool{"a": true}
+		err := UnmarshalJSONObject([]byte(`{"b":false}`), MapOf(&m))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, map[string]bool{"a": true, "b": false}, m)
+	})
+}
+
+func TestDecodeSliceKey(t *testing.T) {
+	var ids []int64
+	var counts map[string]uint64
+	err := UnmarshalJSONObject([]byte(`{"ids":[1,2],"counts":{"x":1}}`), DecodeObjectFunc(func(dec *Decoder, key string) error {
+		switch key {
+		case "ids":
+			return dec.Array(SliceOf(&ids))
+		case "counts":
+			return dec.Object(MapOf(&counts))
+		}
+		return nil
+	}))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, []int64{1, 2}, ids)
+	assert.Equal(t, map[string]uint64{"x": 1}, counts)
+}
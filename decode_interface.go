@@ -11,6 +11,7 @@ func (dec *Decoder) DecodeInterface(i *interface{}) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	err := dec.decodeInterface(i)
 	return err
 }
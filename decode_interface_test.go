@@ -1,7 +1,6 @@
 package gojay
 
 import (
-	"encoding/json"
 	"strings"
 	"testing"
 
@@ -63,14 +62,14 @@ func TestDecodeInterfaceBasic(t *testing.T) {
 			name:            "array-error",
 			json:            `["h""o","l","a"]`,
 			err:             true,
-			errType:         &json.SyntaxError{},
+			errType:         InvalidJSONError(""),
 			skipCheckResult: true,
 		},
 		{
 			name:            "object-error",
 			json:            `{"testStr" "hello world!"}`,
 			err:             true,
-			errType:         &json.SyntaxError{},
+			errType:         InvalidJSONError(""),
 			skipCheckResult: true,
 		},
 		{
@@ -207,7 +206,7 @@ func TestDecodeInterfaceAsInterface(t *testing.T) {
         "testInterface": ["a""d","i","o","s"]
       }`,
 			err:             true,
-			errType:         &json.SyntaxError{},
+			errType:         InvalidJSONError(""),
 			skipCheckResult: true,
 		},
 		{
@@ -340,7 +339,7 @@ func TestDecodeAsTestObject(t *testing.T) {
         "testInterface": ["a""d","i","o","s"]
       }`,
 			err:             true,
-			errType:         &json.SyntaxError{},
+			errType:         InvalidJSONError(""),
 			skipCheckResult: true,
 		},
 		{
@@ -503,7 +502,7 @@ func TestUnmarshalInterfaceError(t *testing.T) {
 			err := Unmarshal(testCase.json, v)
 			assert.NotNil(t, err, "Err must be not nil")
 			t.Log(err)
-			assert.IsType(t, &json.SyntaxError{}, err, "err should be a json.SyntaxError{}")
+			assert.IsType(t, InvalidJSONError(""), err, "err should be an InvalidJSONError")
 		})
 	}
 }
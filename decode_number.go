@@ -2,6 +2,8 @@ package gojay
 
 import (
 	"math"
+	"strconv"
+	"unsafe"
 )
 
 var digits []int8
@@ -48,8 +50,6 @@ var pow10uint64 = [21]uint64{
 	10000000000000000000,
 }
 
-var skipNumberEndCursorIncrement [256]int
-
 func init() {
 	digits = make([]int8, 256)
 	for i := 0; i < len(digits); i++ {
@@ -58,33 +58,65 @@ func init() {
 	for i := int8('0'); i <= int8('9'); i++ {
 		digits[i] = i - int8('0')
 	}
+}
 
-	for i := 0; i < 256; i++ {
-		switch i {
-		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '.', 'e', 'E', '+', '-':
-			skipNumberEndCursorIncrement[i] = 1
+// skipNumber skips a number, the cursor must be at its first char.
+// It strictly checks the grammar of the number and returns the cursor after its last char.
+func (dec *Decoder) skipNumber() (int, error) {
+	if dec.data[dec.cursor] == '-' {
+		dec.cursor++
+	}
+	if dec.cursor >= dec.length && !dec.read() {
+		return 0, dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	// integer part, leading zeros are not allowed
+	switch dec.data[dec.cursor] {
+	case '0':
+		dec.cursor++
+	case '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		dec.skipDigits()
+	default:
+		return 0, dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	// fraction
+	if (dec.cursor < dec.length || dec.read()) && dec.data[dec.cursor] == '.' {
+		dec.cursor++
+		if !dec.skipDigits() {
+			return 0, dec.raiseInvalidJSONErr(dec.cursor)
 		}
 	}
+	// exponent
+	if (dec.cursor < dec.length || dec.read()) && (dec.data[dec.cursor] == 'e' || dec.data[dec.cursor] == 'E') {
+		dec.cursor++
+		if (dec.cursor < dec.length || dec.read()) && (dec.data[dec.cursor] == '+' || dec.data[dec.cursor] == '-') {
+			dec.cursor++
+		}
+		if !dec.skipDigits() {
+			return 0, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+	// a number must be followed by the end of its container or of the input
+	end := dec.cursor
+	switch dec.nextNonSpace() {
+	case ',', '}', ']':
+	default:
+		if dec.cursor < dec.length {
+			return 0, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+	dec.cursor = end
+	return end, nil
 }
 
-func (dec *Decoder) skipNumber() (int, error) {
-	end := dec.cursor + 1
-	// look for following numbers
-	for j := dec.cursor + 1; j < dec.length || dec.read(); j++ {
-		end += skipNumberEndCursorIncrement[dec.data[j]]
-
-		switch dec.data[j] {
-		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '.', 'e', 'E', '+', '-', ' ', '\n', '\t', '\r':
-			continue
-		case ',', '}', ']':
-			return end, nil
-		default:
-			// invalid json we expect numbers, dot (single one), comma, or spaces
-			return end, dec.raiseInvalidJSONErr(dec.cursor)
+// skipDigits consumes consecutive digits and reports whether at least one was found.
+func (dec *Decoder) skipDigits() bool {
+	start := dec.cursor
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		if !isDigit(dec.data[dec.cursor]) {
+			break
 		}
 	}
-
-	return end, nil
+	return dec.cursor > start
 }
 
 func (dec *Decoder) getExponent() (int64, error) {
@@ -116,3 +148,65 @@ func (dec *Decoder) getExponent() (int64, error) {
 	}
 	return dec.atoi64(start, end-1), nil
 }
+
+// SetQuotedIntegers makes the decoder accept integers quoted in strings, i.e. "123", as well as bare integers
+// when decoding to int and uint types, as JavaScript clients send int64 values above 2^53 as strings.
+// The string must hold an integer in range of the type decoded to, else an InvalidUnmarshalError is returned.
+func (dec *Decoder) SetQuotedIntegers(on bool) {
+	dec.quotedIntegers = on
+}
+
+// getQuotedInt64 decodes the integer quoted in the string at the cursor to a signed integer of bitSize bits.
+// If the string does not hold such an integer, it returns false and keeps an InvalidUnmarshalError for v.
+func (dec *Decoder) getQuotedInt64(v interface{}, bitSize int) (int64, bool, error) {
+	s, err := dec.getQuotedInteger()
+	if err != nil {
+		return 0, false, err
+	}
+	if s != nil {
+		if val, err := strconv.ParseInt(*(*string)(unsafe.Pointer(&s)), 10, bitSize); err == nil {
+			return val, true, nil
+		}
+	}
+	dec.err = dec.makeInvalidUnmarshalErr(v)
+	return 0, false, nil
+}
+
+// getQuotedUint64 decodes the integer quoted in the string at the cursor to an unsigned integer of bitSize bits.
+// If the string does not hold such an integer, it returns false and keeps an InvalidUnmarshalError for v.
+func (dec *Decoder) getQuotedUint64(v interface{}, bitSize int) (uint64, bool, error) {
+	s, err := dec.getQuotedInteger()
+	if err != nil {
+		return 0, false, err
+	}
+	if s != nil {
+		if val, err := strconv.ParseUint(*(*string)(unsafe.Pointer(&s)), 10, bitSize); err == nil {
+			return val, true, nil
+		}
+	}
+	dec.err = dec.makeInvalidUnmarshalErr(v)
+	return 0, false, nil
+}
+
+// getQuotedInteger returns the content of the string at the cursor, nil if it is not an optional minus sign followed by digits.
+func (dec *Decoder) getQuotedInteger() ([]byte, error) {
+	dec.cursor++
+	start, end, err := dec.getString()
+	if err != nil {
+		return nil, err
+	}
+	s := dec.data[start : end-1]
+	digits := s
+	if len(digits) > 0 && digits[0] == '-' {
+		digits = digits[1:]
+	}
+	if len(digits) == 0 {
+		return nil, nil
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return nil, nil
+		}
+	}
+	return s, nil
+}
@@ -7,6 +7,7 @@ func (dec *Decoder) DecodeFloat64(v *float64) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeFloat64(v)
 }
 func (dec *Decoder) decodeFloat64(v *float64) error {
@@ -215,6 +216,7 @@ func (dec *Decoder) DecodeFloat32(v *float32) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeFloat32(v)
 }
 func (dec *Decoder) decodeFloat32(v *float32) error {
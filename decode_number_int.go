@@ -3,6 +3,7 @@ package gojay
 import (
 	"fmt"
 	"math"
+	"strconv"
 )
 
 // DecodeInt reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the int pointed to by v.
@@ -12,6 +13,7 @@ func (dec *Decoder) DecodeInt(v *int) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeInt(v)
 }
 func (dec *Decoder) decodeInt(v *int) error {
@@ -43,6 +45,13 @@ func (dec *Decoder) decodeInt(v *int) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedInt64(v, strconv.IntSize)
+				if ok {
+					*v = int(val)
+				}
+				return err
+			}
 			dec.err = InvalidUnmarshalError(
 				fmt.Sprintf(
 					"Cannot unmarshall to int, wrong char '%s' found at pos %d",
@@ -95,6 +104,16 @@ func (dec *Decoder) decodeIntNull(v **int) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedInt64(v, strconv.IntSize)
+				if ok {
+					if *v == nil {
+						*v = new(int)
+					}
+					**v = int(val)
+				}
+				return err
+			}
 			dec.err = InvalidUnmarshalError(
 				fmt.Sprintf(
 					"Cannot unmarshall to int, wrong char '%s' found at pos %d",
@@ -119,6 +138,7 @@ func (dec *Decoder) DecodeInt16(v *int16) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeInt16(v)
 }
 func (dec *Decoder) decodeInt16(v *int16) error {
@@ -150,6 +170,13 @@ func (dec *Decoder) decodeInt16(v *int16) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedInt64(v, 16)
+				if ok {
+					*v = int16(val)
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -195,6 +222,16 @@ func (dec *Decoder) decodeInt16Null(v **int16) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedInt64(v, 16)
+				if ok {
+					if *v == nil {
+						*v = new(int16)
+					}
+					**v = int16(val)
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -351,6 +388,7 @@ func (dec *Decoder) DecodeInt8(v *int8) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeInt8(v)
 }
 func (dec *Decoder) decodeInt8(v *int8) error {
@@ -382,6 +420,13 @@ func (dec *Decoder) decodeInt8(v *int8) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedInt64(v, 8)
+				if ok {
+					*v = int8(val)
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -427,6 +472,16 @@ func (dec *Decoder) decodeInt8Null(v **int8) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedInt64(v, 8)
+				if ok {
+					if *v == nil {
+						*v = new(int8)
+					}
+					**v = int8(val)
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -582,6 +637,7 @@ func (dec *Decoder) DecodeInt32(v *int32) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeInt32(v)
 }
 func (dec *Decoder) decodeInt32(v *int32) error {
@@ -612,6 +668,13 @@ func (dec *Decoder) decodeInt32(v *int32) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedInt64(v, 32)
+				if ok {
+					*v = int32(val)
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -656,6 +719,16 @@ func (dec *Decoder) decodeInt32Null(v **int32) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedInt64(v, 32)
+				if ok {
+					if *v == nil {
+						*v = new(int32)
+					}
+					**v = int32(val)
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -812,6 +885,7 @@ func (dec *Decoder) DecodeInt64(v *int64) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeInt64(v)
 }
 
@@ -843,6 +917,13 @@ func (dec *Decoder) decodeInt64(v *int64) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedInt64(v, 64)
+				if ok {
+					*v = val
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -887,6 +968,16 @@ func (dec *Decoder) decodeInt64Null(v **int64) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedInt64(v, 64)
+				if ok {
+					if *v == nil {
+						*v = new(int64)
+					}
+					**v = val
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
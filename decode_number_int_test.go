@@ -3292,3 +3292,109 @@ func TestDecoderInt8Null(t *testing.T) {
 		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
 	})
 }
+
+func TestDecoderQuotedIntegers(t *testing.T) {
+	testCases := []struct {
+		name           string
+		json           string
+		expectedResult interface{}
+		err            bool
+	}{
+		{name: "int", json: `"123"`, expectedResult: int(123)},
+		{name: "int-negative", json: `"-123"`, expectedResult: int(-123)},
+		{name: "int-bare", json: `123`, expectedResult: int(123)},
+		{name: "int8", json: `"-128"`, expectedResult: int8(-128)},
+		{name: "int8-overflow", json: `"128"`, expectedResult: int8(0), err: true},
+		{name: "int16", json: ` "32767" `, expectedResult: int16(32767)},
+		{name: "int32", json: `"-2147483648"`, expectedResult: int32(-2147483648)},
+		{name: "int64", json: `"9007199254740993"`, expectedResult: int64(9007199254740993)},
+		{name: "int64-overflow", json: `"9223372036854775808"`, expectedResult: int64(0), err: true},
+		{name: "empty", json: `""`, expectedResult: int64(0), err: true},
+		{name: "minus-only", json: `"-"`, expectedResult: int64(0), err: true},
+		{name: "plus", json: `"+1"`, expectedResult: int64(0), err: true},
+		{name: "float", json: `"1.5"`, expectedResult: int64(0), err: true},
+		{name: "spaces", json: `" 1"`, expectedResult: int64(0), err: true},
+		{name: "letters", json: `"12a"`, expectedResult: int64(0), err: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dec := NewDecoder(strings.NewReader(testCase.json))
+			dec.SetQuotedIntegers(true)
+			v := reflect.New(reflect.TypeOf(testCase.expectedResult))
+			err := dec.Decode(v.Interface())
+			if testCase.err {
+				assert.NotNil(t, err, "err should not be nil")
+				assert.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+			} else {
+				assert.Nil(t, err, "err should be nil")
+			}
+			assert.Equal(t, testCase.expectedResult, v.Elem().Interface())
+		})
+	}
+	t.Run("null", func(t *testing.T) {
+		var a, b *int
+		var c *int8
+		var d *int64
+		dec := NewDecoder(strings.NewReader(`["1",null,"-8","x"]`))
+		dec.SetQuotedIntegers(true)
+		err := dec.DecodeArray(DecodeArrayFunc(func(dec *Decoder) error {
+			switch dec.Index() {
+			case 0:
+				return dec.IntNull(&a)
+			case 1:
+				return dec.IntNull(&b)
+			case 2:
+				return dec.Int8Null(&c)
+			}
+			return dec.Int64Null(&d)
+		}))
+		assert.Nil(t, err, "err should be nil")
+		assert.IsType(t, InvalidUnmarshalError(""), dec.err, "dec.err should be of type InvalidUnmarshalError")
+		assert.Equal(t, 1, *a)
+		assert.Nil(t, b)
+		assert.Equal(t, int8(-8), *c)
+		assert.Nil(t, d)
+	})
+	t.Run("object", func(t *testing.T) {
+		var id int64
+		var count int
+		dec := NewDecoder(strings.NewReader(`{"id":"9007199254740993","count":2}`))
+		dec.SetQuotedIntegers(true)
+		err := dec.DecodeObject(DecodeObjectFunc(func(dec *Decoder, key string) error {
+			switch key {
+			case "id":
+				return dec.Int64(&id)
+			case "count":
+				return dec.Int(&count)
+			}
+			return nil
+		}))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, int64(9007199254740993), id)
+		assert.Equal(t, 2, count)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		var v int64
+		dec := NewDecoder(strings.NewReader(`"123"`))
+		err := dec.Decode(&v)
+		assert.NotNil(t, err, "err should not be nil")
+		assert.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+		assert.Equal(t, int64(0), v)
+	})
+	t.Run("invalid-json", func(t *testing.T) {
+		var v int64
+		dec := NewDecoder(strings.NewReader(`"123`))
+		dec.SetQuotedIntegers(true)
+		err := dec.Decode(&v)
+		assert.NotNil(t, err, "err should not be nil")
+		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+	})
+	t.Run("borrowed-decoder-reset", func(t *testing.T) {
+		dec := BorrowDecoder(strings.NewReader(`"1"`))
+		dec.SetQuotedIntegers(true)
+		dec.Release()
+		dec = BorrowDecoder(strings.NewReader(`"1"`))
+		defer dec.Release()
+		assert.False(t, dec.quotedIntegers, "quotedIntegers should be false")
+	})
+}
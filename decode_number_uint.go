@@ -11,6 +11,7 @@ func (dec *Decoder) DecodeUint8(v *uint8) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeUint8(v)
 }
 
@@ -41,6 +42,13 @@ func (dec *Decoder) decodeUint8(v *uint8) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedUint64(v, 8)
+				if ok {
+					*v = uint8(val)
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -84,6 +92,16 @@ func (dec *Decoder) decodeUint8Null(v **uint8) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedUint64(v, 8)
+				if ok {
+					if *v == nil {
+						*v = new(uint8)
+					}
+					**v = uint8(val)
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -123,6 +141,7 @@ func (dec *Decoder) DecodeUint16(v *uint16) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeUint16(v)
 }
 
@@ -153,6 +172,13 @@ func (dec *Decoder) decodeUint16(v *uint16) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedUint64(v, 16)
+				if ok {
+					*v = uint16(val)
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -196,6 +222,16 @@ func (dec *Decoder) decodeUint16Null(v **uint16) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedUint64(v, 16)
+				if ok {
+					if *v == nil {
+						*v = new(uint16)
+					}
+					**v = uint16(val)
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -235,6 +271,7 @@ func (dec *Decoder) DecodeUint32(v *uint32) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeUint32(v)
 }
 
@@ -265,6 +302,13 @@ func (dec *Decoder) decodeUint32(v *uint32) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedUint64(v, 32)
+				if ok {
+					*v = uint32(val)
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -308,6 +352,16 @@ func (dec *Decoder) decodeUint32Null(v **uint32) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedUint64(v, 32)
+				if ok {
+					if *v == nil {
+						*v = new(uint32)
+					}
+					**v = uint32(val)
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -347,6 +401,7 @@ func (dec *Decoder) DecodeUint64(v *uint64) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeUint64(v)
 }
 func (dec *Decoder) decodeUint64(v *uint64) error {
@@ -376,6 +431,13 @@ func (dec *Decoder) decodeUint64(v *uint64) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedUint64(v, 64)
+				if ok {
+					*v = val
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -419,6 +481,16 @@ func (dec *Decoder) decodeUint64Null(v **uint64) error {
 			}
 			return nil
 		default:
+			if dec.data[dec.cursor] == '"' && dec.quotedIntegers {
+				val, ok, err := dec.getQuotedUint64(v, 64)
+				if ok {
+					if *v == nil {
+						*v = new(uint64)
+					}
+					**v = val
+				}
+				return err
+			}
 			dec.err = dec.makeInvalidUnmarshalErr(v)
 			err := dec.skipData()
 			if err != nil {
@@ -1329,3 +1329,60 @@ func TestDecoderUint8Null(t *testing.T) {
 		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
 	})
 }
+
+func TestDecoderQuotedUnsignedIntegers(t *testing.T) {
+	testCases := []struct {
+		name           string
+		json           string
+		expectedResult interface{}
+		err            bool
+	}{
+		{name: "uint8", json: `"255"`, expectedResult: uint8(255)},
+		{name: "uint8-overflow", json: `"256"`, expectedResult: uint8(0), err: true},
+		{name: "uint16", json: `"65535"`, expectedResult: uint16(65535)},
+		{name: "uint32", json: `"4294967295"`, expectedResult: uint32(4294967295)},
+		{name: "uint64", json: `"18446744073709551615"`, expectedResult: uint64(18446744073709551615)},
+		{name: "uint64-bare", json: `42`, expectedResult: uint64(42)},
+		{name: "negative", json: `"-1"`, expectedResult: uint64(0), err: true},
+		{name: "letters", json: `"1e3"`, expectedResult: uint64(0), err: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dec := NewDecoder(strings.NewReader(testCase.json))
+			dec.SetQuotedIntegers(true)
+			v := reflect.New(reflect.TypeOf(testCase.expectedResult))
+			err := dec.Decode(v.Interface())
+			if testCase.err {
+				assert.NotNil(t, err, "err should not be nil")
+				assert.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+			} else {
+				assert.Nil(t, err, "err should be nil")
+			}
+			assert.Equal(t, testCase.expectedResult, v.Elem().Interface())
+		})
+	}
+	t.Run("null", func(t *testing.T) {
+		var a *uint8
+		var b *uint16
+		var c *uint32
+		var d *uint64
+		dec := NewDecoder(strings.NewReader(`["8","16",null,"64"]`))
+		dec.SetQuotedIntegers(true)
+		err := dec.DecodeArray(DecodeArrayFunc(func(dec *Decoder) error {
+			switch dec.Index() {
+			case 0:
+				return dec.Uint8Null(&a)
+			case 1:
+				return dec.Uint16Null(&b)
+			case 2:
+				return dec.Uint32Null(&c)
+			}
+			return dec.Uint64Null(&d)
+		}))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, uint8(8), *a)
+		assert.Equal(t, uint16(16), *b)
+		assert.Nil(t, c)
+		assert.Equal(t, uint64(64), *d)
+	})
+}
@@ -2,7 +2,6 @@ package gojay
 
 import (
 	"reflect"
-	"unsafe"
 )
 
 // DecodeObject reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the value pointed to by v.
@@ -14,6 +13,7 @@ func (dec *Decoder) DecodeObject(j UnmarshalerJSONObject) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	_, err := dec.decodeObject(j)
 	return err
 }
@@ -43,7 +43,7 @@ func (dec *Decoder) decodeObject(j UnmarshalerJSONObject) (int, error) {
 						if err != nil {
 							return 0, err
 						}
-					} else {
+					} else if dec.called&2 == 0 {
 						dec.keysDone++
 					}
 					dec.called &= 0
@@ -65,7 +65,7 @@ func (dec *Decoder) decodeObject(j UnmarshalerJSONObject) (int, error) {
 						if err != nil {
 							return 0, err
 						}
-					} else {
+					} else if dec.called&2 == 0 {
 						dec.keysDone++
 					}
 					dec.called &= 0
@@ -75,7 +75,7 @@ func (dec *Decoder) decodeObject(j UnmarshalerJSONObject) (int, error) {
 			// in that case, we make sure cursor goes to the end of object, but we skip
 			// unmarshalling
 			if dec.child&1 != 0 {
-				end, err := dec.skipObject()
+				end, err := dec.skipMembers(true)
 				dec.cursor = end
 				return dec.cursor, err
 			}
@@ -142,7 +142,7 @@ func (dec *Decoder) decodeObjectNull(v interface{}) (int, error) {
 						if err != nil {
 							return 0, err
 						}
-					} else {
+					} else if dec.called&2 == 0 {
 						dec.keysDone++
 					}
 					dec.called &= 0
@@ -164,7 +164,7 @@ func (dec *Decoder) decodeObjectNull(v interface{}) (int, error) {
 						if err != nil {
 							return 0, err
 						}
-					} else {
+					} else if dec.called&2 == 0 {
 						dec.keysDone++
 					}
 					dec.called &= 0
@@ -174,7 +174,7 @@ func (dec *Decoder) decodeObjectNull(v interface{}) (int, error) {
 			// in that case, we make sure cursor goes to the end of object, but we skip
 			// unmarshalling
 			if dec.child&1 != 0 {
-				end, err := dec.skipObject()
+				end, err := dec.skipMembers(true)
 				dec.cursor = end
 				return dec.cursor, err
 			}
@@ -199,56 +199,47 @@ func (dec *Decoder) decodeObjectNull(v interface{}) (int, error) {
 	return 0, dec.raiseInvalidJSONErr(dec.cursor)
 }
 
+// skipObject skips an object, the cursor must be right after its opening brace.
+// It strictly checks the grammar of the object and returns the cursor after its closing brace.
 func (dec *Decoder) skipObject() (int, error) {
-	var objectsOpen = 1
-	var objectsClosed = 0
-	for j := dec.cursor; j < dec.length || dec.read(); j++ {
-		switch dec.data[j] {
-		case '}':
-			objectsClosed++
-			// everything is closed return
-			if objectsOpen == objectsClosed {
-				// add char to object data
-				return j + 1, nil
-			}
-		case '{':
-			objectsOpen++
-		case '"':
-			j++
-			var isInEscapeSeq bool
-			var isFirstQuote = true
-			for ; j < dec.length || dec.read(); j++ {
-				if dec.data[j] != '"' {
-					continue
-				}
-				if dec.data[j-1] != '\\' || (!isInEscapeSeq && !isFirstQuote) {
-					break
-				} else {
-					isInEscapeSeq = false
-				}
-				if isFirstQuote {
-					isFirstQuote = false
-				}
-				// loop backward and count how many anti slash found
-				// to see if string is effectively escaped
-				ct := 0
-				for i := j - 1; i > 0; i-- {
-					if dec.data[i] != '\\' {
-						break
-					}
-					ct++
-				}
-				// is pair number of slashes, quote is not escaped
-				if ct&1 == 0 {
-					break
-				}
-				isInEscapeSeq = true
+	if dec.nextNonSpace() == '}' {
+		dec.cursor++
+		return dec.cursor, nil
+	}
+	return dec.skipMembers(false)
+}
+
+// skipMembers skips the members of an object up to its closing brace,
+// the cursor must be right after a member value if afterValue is true, else at the start of a member.
+func (dec *Decoder) skipMembers(afterValue bool) (int, error) {
+	for {
+		if afterValue {
+			switch dec.nextNonSpace() {
+			case ',':
+				dec.cursor++
+			case '}':
+				dec.cursor++
+				return dec.cursor, nil
+			default:
+				return 0, dec.raiseInvalidJSONErr(dec.cursor)
 			}
-		default:
-			continue
 		}
+		if dec.nextNonSpace() != '"' {
+			return 0, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		dec.cursor++
+		if err := dec.skipString(); err != nil {
+			return 0, err
+		}
+		if dec.nextNonSpace() != ':' {
+			return 0, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		dec.cursor++
+		if err := dec.skipValue(); err != nil {
+			return 0, err
+		}
+		afterValue = true
 	}
-	return 0, dec.raiseInvalidJSONErr(dec.cursor)
 }
 
 func (dec *Decoder) nextKey() (string, bool, error) {
@@ -271,8 +262,7 @@ func (dec *Decoder) nextKey() (string, bool, error) {
 			}
 			if found&1 != 0 {
 				dec.cursor++
-				d := dec.data[start : end-1]
-				return *(*string)(unsafe.Pointer(&d)), false, nil
+				return dec.key(dec.data[start : end-1]), false, nil
 			}
 			return "", false, dec.raiseInvalidJSONErr(dec.cursor)
 		case '}':
@@ -367,6 +357,15 @@ func (dec *Decoder) AddObjectNull(v interface{}) error {
 	return dec.ObjectNull(v)
 }
 
+// DuplicateKey tells the decoder the key being decoded was already decoded in the current object.
+// The key is not counted in the keys decoded, so that a duplicate key does not stop the decoding
+// before the number of keys returned by NKeys is reached.
+//
+// It must be called by UnmarshalJSONObject once the value is decoded, i.e. in a deferred call.
+func (dec *Decoder) DuplicateKey() {
+	dec.called |= 2
+}
+
 // Object decodes the JSON value within an object or an array to a UnmarshalerJSONObject.
 func (dec *Decoder) Object(value UnmarshalerJSONObject) error {
 	initialKeysDone := dec.keysDone
@@ -0,0 +1,265 @@
+package gojay
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Get returns the raw JSON value referenced by the JSON Pointer (RFC 6901) in data.
+//
+// The document is walked with the decoder's skip functions and only the targeted value is returned,
+// the returned slice is a sub slice of data, it is not copied.
+// If the pointer does not reference any value, ErrPointerNotFound is returned.
+func Get(data []byte, pointer string) ([]byte, error) {
+	dec := borrowDecoder(nil, 0)
+	defer dec.Release()
+	dec.data = data
+	dec.length = len(data)
+	start, end, err := dec.seekPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	return data[start:end], nil
+}
+
+// GetString decodes the JSON string referenced by the JSON Pointer in data.
+func GetString(data []byte, pointer string) (string, error) {
+	raw, err := Get(data, pointer)
+	if err != nil {
+		return "", err
+	}
+	var v string
+	// decoding a string may unescape it in place, decode from a copy to leave data untouched
+	b := make([]byte, len(raw))
+	copy(b, raw)
+	err = Unmarshal(b, &v)
+	return v, err
+}
+
+// GetInt64 decodes the JSON number referenced by the JSON Pointer in data to an int64.
+func GetInt64(data []byte, pointer string) (int64, error) {
+	raw, err := Get(data, pointer)
+	if err != nil {
+		return 0, err
+	}
+	var v int64
+	err = Unmarshal(raw, &v)
+	return v, err
+}
+
+// GetFloat64 decodes the JSON number referenced by the JSON Pointer in data to a float64.
+func GetFloat64(data []byte, pointer string) (float64, error) {
+	raw, err := Get(data, pointer)
+	if err != nil {
+		return 0, err
+	}
+	var v float64
+	err = Unmarshal(raw, &v)
+	return v, err
+}
+
+// GetBool decodes the JSON boolean referenced by the JSON Pointer in data.
+func GetBool(data []byte, pointer string) (bool, error) {
+	raw, err := Get(data, pointer)
+	if err != nil {
+		return false, err
+	}
+	var v bool
+	err = Unmarshal(raw, &v)
+	return v, err
+}
+
+// GetObject decodes the JSON object referenced by the JSON Pointer in data to v.
+func GetObject(data []byte, pointer string, v UnmarshalerJSONObject) error {
+	raw, err := Get(data, pointer)
+	if err != nil {
+		return err
+	}
+	return UnmarshalJSONObject(raw, v)
+}
+
+// GetArray decodes the JSON array referenced by the JSON Pointer in data to v.
+func GetArray(data []byte, pointer string, v UnmarshalerJSONArray) error {
+	raw, err := Get(data, pointer)
+	if err != nil {
+		return err
+	}
+	return UnmarshalJSONArray(raw, v)
+}
+
+// seekPointer walks the document to the value referenced by pointer
+// and returns the offsets of its first and last (excluded) bytes.
+func (dec *Decoder) seekPointer(pointer string) (int, int, error) {
+	if err := checkPointer(pointer); err != nil {
+		return 0, 0, err
+	}
+	for pointer != "" {
+		var token string
+		token, pointer = nextPointerToken(pointer)
+		if err := dec.seekToken(token); err != nil {
+			return 0, 0, err
+		}
+	}
+	return dec.seekValueEnd()
+}
+
+// seekToken moves the cursor to the value referenced by token in the object or array found at the cursor.
+func (dec *Decoder) seekToken(token string) error {
+	switch dec.nextNonSpace() {
+	case '{':
+		dec.cursor++
+		for {
+			switch dec.nextNonSpace() {
+			case '"':
+			case '}':
+				return ErrPointerNotFound
+			default:
+				return dec.raiseInvalidJSONErr(dec.cursor)
+			}
+			dec.cursor++
+			keyStart := dec.cursor
+			if err := dec.skipString(); err != nil {
+				return err
+			}
+			keyEnd := dec.cursor - 1
+			if dec.nextNonSpace() != ':' {
+				return dec.raiseInvalidJSONErr(dec.cursor)
+			}
+			dec.cursor++
+			if matchPointerToken(dec.data[keyStart:keyEnd], token) {
+				return nil
+			}
+			if err := dec.skipData(); err != nil {
+				return err
+			}
+			switch dec.nextNonSpace() {
+			case ',':
+				dec.cursor++
+			case '}':
+				return ErrPointerNotFound
+			default:
+				return dec.raiseInvalidJSONErr(dec.cursor)
+			}
+		}
+	case '[':
+		dec.cursor++
+		index, ok := parsePointerIndex(token)
+		if !ok {
+			return ErrPointerNotFound
+		}
+		for i := 0; ; i++ {
+			switch dec.nextNonSpace() {
+			case ']':
+				return ErrPointerNotFound
+			case 0:
+				return dec.raiseInvalidJSONErr(dec.cursor)
+			}
+			if i == index {
+				return nil
+			}
+			if err := dec.skipData(); err != nil {
+				return err
+			}
+			switch dec.nextNonSpace() {
+			case ',':
+				dec.cursor++
+			case ']':
+				return ErrPointerNotFound
+			default:
+				return dec.raiseInvalidJSONErr(dec.cursor)
+			}
+		}
+	case 0:
+		return dec.raiseInvalidJSONErr(dec.cursor)
+	default:
+		// scalar values can't be referenced into
+		return ErrPointerNotFound
+	}
+}
+
+// seekValueEnd returns the offsets of the value found at the cursor.
+func (dec *Decoder) seekValueEnd() (int, int, error) {
+	if dec.nextNonSpace() == 0 {
+		return 0, 0, dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	start := dec.cursor
+	if err := dec.skipData(); err != nil {
+		return 0, 0, err
+	}
+	return start, dec.cursor, nil
+}
+
+// checkPointer checks the syntax of a JSON Pointer as defined in RFC 6901.
+func checkPointer(pointer string) error {
+	if pointer != "" && pointer[0] != '/' {
+		return InvalidPointerError("JSON Pointer must be empty or start with '/'")
+	}
+	for i := 0; i < len(pointer); i++ {
+		if pointer[i] == '~' && (i+1 == len(pointer) || (pointer[i+1] != '0' && pointer[i+1] != '1')) {
+			return InvalidPointerError("Invalid escape sequence in JSON Pointer")
+		}
+	}
+	return nil
+}
+
+// nextPointerToken returns the first reference token of pointer, still escaped, and the remaining pointer.
+func nextPointerToken(pointer string) (string, string) {
+	pointer = pointer[1:]
+	i := strings.IndexByte(pointer, '/')
+	if i < 0 {
+		return pointer, ""
+	}
+	return pointer[:i], pointer[i:]
+}
+
+// parsePointerIndex parses an array index reference token, leading zeros are not allowed.
+func parsePointerIndex(token string) (int, bool) {
+	if token == "" || len(token) > maxInt64Length-1 || (token[0] == '0' && len(token) > 1) {
+		return 0, false
+	}
+	index := 0
+	for i := 0; i < len(token); i++ {
+		if !isDigit(token[i]) {
+			return 0, false
+		}
+		index = index*10 + int(token[i]-'0')
+	}
+	return index, true
+}
+
+// matchPointerToken reports whether the raw key (JSON escaped) equals the reference token (pointer escaped).
+func matchPointerToken(key []byte, token string) bool {
+	if bytes.IndexByte(key, '\\') >= 0 {
+		key = unescapeKey(key)
+	}
+	i := 0
+	for j := 0; j < len(token); j++ {
+		c := token[j]
+		if c == '~' {
+			j++
+			if token[j] == '0' {
+				c = '~'
+			} else {
+				c = '/'
+			}
+		}
+		if i >= len(key) || key[i] != c {
+			return false
+		}
+		i++
+	}
+	return i == len(key)
+}
+
+// unescapeKey returns an unescaped copy of a JSON escaped key.
+func unescapeKey(key []byte) []byte {
+	b := make([]byte, len(key)+1)
+	copy(b, key)
+	b[len(key)] = '"'
+	dec := &Decoder{data: b, length: len(b)}
+	start, end, err := dec.getString()
+	if err != nil {
+		return key
+	}
+	return dec.data[start : end-1]
+}
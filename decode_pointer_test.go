@@ -0,0 +1,134 @@
+package gojay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pointerTestJSON = []byte(`{
+	"users": [
+		{"id": 1, "name": "John", "admin": true, "score": 1.5},
+		{"id": 2, "name": "Jane\nDoe", "admin": false, "tags": ["a", "b"]},
+		{"id": 3, "name": "Bob", "address": {"city": "Paris"}}
+	],
+	"a/b": "slash",
+	"m~n": "tilde",
+	"esc\"aped": "quote",
+	"": "empty",
+	"n": null,
+	"count": 42
+}`)
+
+func TestGet(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pointer  string
+		expected string
+		err      error
+	}{
+		{name: "whole-document", pointer: "", expected: string(pointerTestJSON)},
+		{name: "array-element", pointer: "/users/0", expected: `{"id": 1, "name": "John", "admin": true, "score": 1.5}`},
+		{name: "nested-string", pointer: "/users/1/name", expected: `"Jane\nDoe"`},
+		{name: "nested-array", pointer: "/users/1/tags", expected: `["a", "b"]`},
+		{name: "nested-array-element", pointer: "/users/1/tags/1", expected: `"b"`},
+		{name: "nested-object", pointer: "/users/2/address", expected: `{"city": "Paris"}`},
+		{name: "number", pointer: "/count", expected: `42`},
+		{name: "number-in-object", pointer: "/users/0/id", expected: `1`},
+		{name: "bool", pointer: "/users/0/admin", expected: `true`},
+		{name: "null", pointer: "/n", expected: `null`},
+		{name: "escaped-slash", pointer: "/a~1b", expected: `"slash"`},
+		{name: "escaped-tilde", pointer: "/m~0n", expected: `"tilde"`},
+		{name: "escaped-key", pointer: `/esc"aped`, expected: `"quote"`},
+		{name: "empty-key", pointer: "/", expected: `"empty"`},
+		{name: "missing-key", pointer: "/nope", err: ErrPointerNotFound},
+		{name: "index-out-of-range", pointer: "/users/3", err: ErrPointerNotFound},
+		{name: "index-dash", pointer: "/users/-", err: ErrPointerNotFound},
+		{name: "index-leading-zero", pointer: "/users/01", err: ErrPointerNotFound},
+		{name: "index-in-scalar", pointer: "/count/0", err: ErrPointerNotFound},
+		{name: "invalid-pointer", pointer: "users", err: InvalidPointerError("JSON Pointer must be empty or start with '/'")},
+		{name: "invalid-escape", pointer: "/m~2n", err: InvalidPointerError("Invalid escape sequence in JSON Pointer")},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v, err := Get(pointerTestJSON, testCase.pointer)
+			if testCase.err != nil {
+				assert.Equal(t, testCase.err, err, "err should be the expected one")
+				return
+			}
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, string(v), "v should be the expected value")
+		})
+	}
+}
+
+func TestGetInvalidJSON(t *testing.T) {
+	_, err := Get([]byte(`{"a":1,"b"}`), "/c")
+	assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+	_, err = Get([]byte(`[1,2`), "/3")
+	assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+	_, err = Get([]byte(``), "")
+	assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+}
+
+func TestGetTyped(t *testing.T) {
+	cp := string(pointerTestJSON)
+
+	s, err := GetString(pointerTestJSON, "/users/1/name")
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, "Jane\nDoe", s, "s should be unescaped")
+	assert.Equal(t, cp, string(pointerTestJSON), "input should not be modified")
+
+	i, err := GetInt64(pointerTestJSON, "/users/2/id")
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, int64(3), i, "i should be 3")
+
+	f, err := GetFloat64(pointerTestJSON, "/users/0/score")
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, 1.5, f, "f should be 1.5")
+
+	b, err := GetBool(pointerTestJSON, "/users/0/admin")
+	assert.Nil(t, err, "err should be nil")
+	assert.True(t, b, "b should be true")
+
+	_, err = GetInt64(pointerTestJSON, "/users/0/name")
+	assert.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+
+	_, err = GetString(pointerTestJSON, "/nope")
+	assert.Equal(t, ErrPointerNotFound, err, "err should be ErrPointerNotFound")
+	_, err = GetInt64(pointerTestJSON, "/nope")
+	assert.Equal(t, ErrPointerNotFound, err, "err should be ErrPointerNotFound")
+	_, err = GetFloat64(pointerTestJSON, "/nope")
+	assert.Equal(t, ErrPointerNotFound, err, "err should be ErrPointerNotFound")
+	_, err = GetBool(pointerTestJSON, "/nope")
+	assert.Equal(t, ErrPointerNotFound, err, "err should be ErrPointerNotFound")
+}
+
+func TestGetObjectAndArray(t *testing.T) {
+	var city string
+	err := GetObject(pointerTestJSON, "/users/2/address", DecodeObjectFunc(func(dec *Decoder, k string) error {
+		if k == "city" {
+			return dec.String(&city)
+		}
+		return nil
+	}))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, "Paris", city, "city should be Paris")
+
+	var tags []string
+	err = GetArray(pointerTestJSON, "/users/1/tags", DecodeArrayFunc(func(dec *Decoder) error {
+		var tag string
+		if err := dec.String(&tag); err != nil {
+			return err
+		}
+		tags = append(tags, tag)
+		return nil
+	}))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, []string{"a", "b"}, tags, "tags should be decoded")
+
+	err = GetObject(pointerTestJSON, "/nope", DecodeObjectFunc(func(dec *Decoder, k string) error { return nil }))
+	assert.Equal(t, ErrPointerNotFound, err, "err should be ErrPointerNotFound")
+	err = GetArray(pointerTestJSON, "/nope", DecodeArrayFunc(func(dec *Decoder) error { return nil }))
+	assert.Equal(t, ErrPointerNotFound, err, "err should be ErrPointerNotFound")
+}
@@ -11,26 +11,29 @@ var decPool = sync.Pool{
 
 func init() {
 	for i := 0; i < 32; i++ {
-		decPool.Put(NewDecoder(nil))
+		decPool.Put(newDecoderPool())
 	}
 }
 
 // NewDecoder returns a new decoder.
 // It takes an io.Reader implementation as data input.
 func NewDecoder(r io.Reader) *Decoder {
-	return &Decoder{
+	dec := &Decoder{
 		called:   0,
 		cursor:   0,
 		keysDone: 0,
 		err:      nil,
 		r:        r,
-		data:     make([]byte, 512),
 		length:   0,
 		isPooled: 0,
 	}
+	dec.borrowBuffer(512)
+	return dec
 }
+
+// decoders in the pool have no buffer, buffers are pooled by size class
 func newDecoderPool() interface{} {
-	return NewDecoder(nil)
+	return &Decoder{}
 }
 
 // BorrowDecoder borrows a Decoder from the pool.
@@ -49,8 +52,9 @@ func borrowDecoder(r io.Reader, bufSize int) *Decoder {
 	dec.r = r
 	dec.length = 0
 	dec.isPooled = 0
+	dec.quotedIntegers = false
 	if bufSize > 0 {
-		dec.data = make([]byte, bufSize)
+		dec.borrowBuffer(bufSize)
 	}
 	return dec
 }
@@ -58,7 +62,11 @@ func borrowDecoder(r io.Reader, bufSize int) *Decoder {
 // Release sends back a Decoder to the pool.
 // If a decoder is used after calling Release
 // a panic will be raised with an InvalidUsagePooledDecoderError error.
+//
+// The decoder buffer goes back to the pools too, unless decoded strings alias it.
 func (dec *Decoder) Release() {
 	dec.isPooled = 1
+	dec.releaseBuffer()
+	dec.data = nil
 	decPool.Put(dec)
 }
@@ -0,0 +1,92 @@
+//go:build go1.23
+
+package gojay
+
+import (
+	"errors"
+	"io"
+	"iter"
+)
+
+// errSeqBreak stops decoding an array when the loop over its elements breaks.
+var errSeqBreak = errors.New("gojay: loop over array elements stopped")
+
+// Seq returns an iterator over the elements of the next JSON array, it yields the index of each element
+// for the loop body to decode it with the methods decoding values in arrays, i.e. dec.Object or dec.String.
+// An element the body does not decode is skipped. When reading from an io.Reader,
+// the elements are read one at a time and the decoded ones are dropped from the buffer.
+//
+// Once an error is yielded the iteration stops. Breaking out of the loop leaves the rest of the array undecoded.
+//	for _, err := range dec.Seq() {
+//		if err != nil {
+//			return err
+//		}
+//		var u User
+//		if err := dec.Object(&u); err != nil {
+//			return err
+//		}
+//	}
+func (dec *Decoder) Seq() iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		if dec.isPooled == 1 {
+			panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+		}
+		dec.compact()
+		var i int
+		var stopped bool
+		_, err := dec.decodeArray(DecodeArrayFunc(func(dec *Decoder) error {
+			// no offset in the buffer is held between elements
+			dec.compact()
+			start := dec.cursor
+			if !yield(i, nil) {
+				stopped = true
+				return errSeqBreak
+			}
+			i++
+			if dec.cursor == start {
+				return dec.skipData()
+			}
+			return nil
+		}))
+		if stopped {
+			return
+		}
+		if err == nil {
+			err = dec.err
+		}
+		if err != nil {
+			yield(i, err)
+		}
+	}
+}
+
+// DecodeArraySeq returns an iterator over the elements of the JSON array read from r,
+// each element is decoded to a T as the elements of a Slice.
+// The elements are read lazily, one at a time, the iterator can be used once.
+//
+// Once an error is yielded the iteration stops.
+//	for u, err := range gojay.DecodeArraySeq[User](r) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(u.Name)
+//	}
+func DecodeArraySeq[T any](r io.Reader) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		dec := BorrowDecoder(r)
+		defer dec.Release()
+		for _, err := range dec.Seq() {
+			var v T
+			if err == nil {
+				err = decodeValue(dec, &v)
+			}
+			if err == nil {
+				// an element of another type is reported at once
+				err = dec.err
+			}
+			if !yield(v, err) || err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,201 @@
+//go:build go1.23
+
+package gojay
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testSeqUser struct {
+	id   int
+	name string
+}
+
+func (u *testSeqUser) UnmarshalJSONObject(dec *Decoder, key string) error {
+	switch key {
+	case "id":
+		return dec.Int(&u.id)
+	case "name":
+		return dec.String(&u.name)
+	}
+	return nil
+}
+
+func (u *testSeqUser) NKeys() int {
+	return 2
+}
+
+// testSeqReader reads an array of n users, generated as they are read.
+type testSeqReader struct {
+	n    int
+	i    int
+	buf  []byte
+	read int
+}
+
+func (r *testSeqReader) Read(b []byte) (int, error) {
+	for len(r.buf) < len(b) && r.i <= r.n {
+		switch {
+		case r.i == 0:
+			r.buf = append(r.buf, '[')
+		case r.i == r.n:
+			r.buf = append(r.buf, ']')
+		default:
+			if r.i > 1 {
+				r.buf = append(r.buf, ',')
+			}
+			r.buf = append(r.buf, `{"id":`...)
+			r.buf = strconv.AppendInt(r.buf, int64(r.i), 10)
+			r.buf = append(r.buf, `,"name":"user"}`...)
+		}
+		r.i++
+	}
+	if len(r.buf) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(b, r.buf)
+	r.buf = r.buf[n:]
+	r.read += n
+	return n, nil
+}
+
+func TestDecoderSeq(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		dec := NewDecoder(strings.NewReader(`[{"id":1,"name":"a"}, "b", 3]`))
+		var indexes []int
+		var u testSeqUser
+		var s string
+		var n int
+		for i, err := range dec.Seq() {
+			assert.Nil(t, err, "err should be nil")
+			indexes = append(indexes, i)
+			switch i {
+			case 0:
+				err = dec.Object(&u)
+			case 1:
+				err = dec.String(&s)
+			case 2:
+				err = dec.Int(&n)
+			}
+			assert.Nil(t, err, "err should be nil")
+		}
+		assert.Equal(t, []int{0, 1, 2}, indexes)
+		assert.Equal(t, testSeqUser{id: 1, name: "a"}, u)
+		assert.Equal(t, "b", s)
+		assert.Equal(t, 3, n)
+	})
+	t.Run("skip-elements-not-decoded", func(t *testing.T) {
+		dec := NewDecoder(strings.NewReader(`[{"id":1,"name":"a"},[1,2],"c",4]`))
+		var n int
+		for i, err := range dec.Seq() {
+			assert.Nil(t, err, "err should be nil")
+			if i == 3 {
+				assert.Nil(t, dec.Int(&n), "err should be nil")
+			}
+		}
+		assert.Equal(t, 4, n)
+	})
+	t.Run("null", func(t *testing.T) {
+		dec := NewDecoder(strings.NewReader(`null`))
+		for range dec.Seq() {
+			assert.True(t, false, "should not yield")
+		}
+	})
+	t.Run("invalid-json", func(t *testing.T) {
+		dec := NewDecoder(strings.NewReader(`[1,2`))
+		var errs []error
+		for _, err := range dec.Seq() {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			var n int
+			assert.Nil(t, dec.Int(&n), "err should be nil")
+		}
+		assert.Len(t, errs, 1)
+		assert.IsType(t, InvalidJSONError(""), errs[0], "err should be an InvalidJSONError")
+	})
+	t.Run("not-an-array", func(t *testing.T) {
+		dec := NewDecoder(strings.NewReader(`{"id":1}`))
+		var errs []error
+		for _, err := range dec.Seq() {
+			errs = append(errs, err)
+		}
+		assert.Len(t, errs, 1)
+		assert.IsType(t, InvalidUnmarshalError(""), errs[0], "err should be an InvalidUnmarshalError")
+	})
+	t.Run("bounded-buffer", func(t *testing.T) {
+		dec := NewDecoder(&testSeqReader{n: 10000})
+		var count int
+		for _, err := range dec.Seq() {
+			var u testSeqUser
+			assert.Nil(t, err, "err should be nil")
+			assert.Nil(t, dec.Object(&u), "err should be nil")
+			count++
+		}
+		assert.Equal(t, 9999, count)
+		assert.True(t, len(dec.data) <= 4096, "the buffer should not grow with the array")
+	})
+	t.Run("pool-error", func(t *testing.T) {
+		dec := NewDecoder(strings.NewReader(`[]`))
+		dec.Release()
+		defer func() {
+			err := recover()
+			assert.NotNil(t, err, "err shouldnt be nil")
+			assert.IsType(t, InvalidUsagePooledDecoderError(""), err, "err should be of type InvalidUsagePooledDecoderError")
+		}()
+		for range dec.Seq() {
+		}
+		assert.True(t, false, "should not be called as it should have panicked")
+	})
+}
+
+func TestDecodeArraySeq(t *testing.T) {
+	t.Run("objects", func(t *testing.T) {
+		var users []testSeqUser
+		for u, err := range DecodeArraySeq[testSeqUser](strings.NewReader(`[{"id":1,"name":"a"},{"id":2,"name":"b"}]`)) {
+			assert.Nil(t, err, "err should be nil")
+			users = append(users, u)
+		}
+		assert.Equal(t, []testSeqUser{{id: 1, name: "a"}, {id: 2, name: "b"}}, users)
+	})
+	t.Run("strings", func(t *testing.T) {
+		var s []string
+		for v, err := range DecodeArraySeq[string](strings.NewReader(`["a","b","c"]`)) {
+			assert.Nil(t, err, "err should be nil")
+			s = append(s, v)
+		}
+		assert.Equal(t, []string{"a", "b", "c"}, s)
+	})
+	t.Run("lazy", func(t *testing.T) {
+		r := &testSeqReader{n: 100000}
+		var count int
+		for _, err := range DecodeArraySeq[testSeqUser](r) {
+			assert.Nil(t, err, "err should be nil")
+			if count++; count == 3 {
+				break
+			}
+		}
+		assert.Equal(t, 3, count)
+		assert.True(t, r.read < 4096, "only the first elements should be read")
+	})
+	t.Run("element-error", func(t *testing.T) {
+		var values []int
+		var errs []error
+		for v, err := range DecodeArraySeq[int](strings.NewReader(`[1,"a",3]`)) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			values = append(values, v)
+		}
+		assert.Equal(t, []int{1}, values)
+		assert.Len(t, errs, 1)
+		assert.IsType(t, InvalidUnmarshalError(""), errs[0], "err should be an InvalidUnmarshalError")
+	})
+}
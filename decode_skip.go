@@ -0,0 +1,46 @@
+package gojay
+
+// Kind is the kind of a JSON value.
+type Kind byte
+
+// Kinds of JSON values returned by Peek.
+const (
+	InvalidKind Kind = iota
+	ObjectKind
+	ArrayKind
+	StringKind
+	NumberKind
+	BoolKind
+	NullKind
+)
+
+// Peek returns the kind of the next JSON value of the decoder's input without consuming it.
+// If the input is exhausted or the next char cannot start a JSON value, InvalidKind is returned.
+func (dec *Decoder) Peek() Kind {
+	switch dec.nextChar() {
+	case '{':
+		return ObjectKind
+	case '[':
+		return ArrayKind
+	case '"':
+		return StringKind
+	case 't', 'f':
+		return BoolKind
+	case 'n':
+		return NullKind
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return NumberKind
+	}
+	return InvalidKind
+}
+
+// Skip skips the next JSON value of the decoder's input without decoding it.
+// Within an object or an array, a skipped value is considered as decoded.
+func (dec *Decoder) Skip() error {
+	err := dec.skipData()
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
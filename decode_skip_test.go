@@ -0,0 +1,70 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecoderPeek(t *testing.T) {
+	testCases := []struct {
+		json     string
+		expected Kind
+	}{
+		{json: ` {"a":1}`, expected: ObjectKind},
+		{json: "\n[1]", expected: ArrayKind},
+		{json: `"a"`, expected: StringKind},
+		{json: `-1`, expected: NumberKind},
+		{json: `12`, expected: NumberKind},
+		{json: `true`, expected: BoolKind},
+		{json: `false`, expected: BoolKind},
+		{json: `null`, expected: NullKind},
+		{json: `   `, expected: InvalidKind},
+		{json: `}`, expected: InvalidKind},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.json, func(t *testing.T) {
+			dec := NewDecoder(strings.NewReader(testCase.json))
+			assert.Equal(t, testCase.expected, dec.Peek(), "kind should be the expected one")
+			// peeking does not consume the value
+			assert.Equal(t, testCase.expected, dec.Peek(), "kind should be the expected one")
+		})
+	}
+}
+
+func TestDecoderSkip(t *testing.T) {
+	var values []int
+	var b string
+	dec := NewDecoder(strings.NewReader(`{"a":{"b":[1,2]},"b":"c","d":[1,[2,3],{"e":4},5]}`))
+	err := dec.DecodeObject(DecodeObjectFunc(func(dec *Decoder, k string) error {
+		switch k {
+		case "a":
+			return dec.Skip()
+		case "b":
+			return dec.String(&b)
+		case "d":
+			return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
+				if dec.Peek() != NumberKind {
+					return dec.Skip()
+				}
+				var i int
+				if err := dec.Int(&i); err != nil {
+					return err
+				}
+				values = append(values, i)
+				return nil
+			}))
+		}
+		return nil
+	}))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, "c", b, "b should be decoded")
+	assert.Equal(t, []int{1, 5}, values, "values should be decoded")
+
+	dec = NewDecoder(strings.NewReader(`{"a":tru}`))
+	err = dec.DecodeObject(DecodeObjectFunc(func(dec *Decoder, k string) error {
+		return dec.Skip()
+	}))
+	assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+}
@@ -43,9 +43,10 @@ func (s stream) borrowDecoder(r io.Reader, bufSize int) *StreamDecoder {
 	streamDec.r = r
 	streamDec.length = 0
 	streamDec.isPooled = 0
+	streamDec.quotedIntegers = false
 	streamDec.done = make(chan struct{}, 1)
 	if bufSize > 0 {
-		streamDec.data = make([]byte, bufSize)
+		streamDec.borrowBuffer(bufSize)
 	}
 	return streamDec
 }
@@ -55,5 +56,7 @@ func (s stream) borrowDecoder(r io.Reader, bufSize int) *StreamDecoder {
 // a panic will be raised with an InvalidUsagePooledDecoderError error.
 func (dec *StreamDecoder) Release() {
 	dec.isPooled = 1
+	dec.releaseBuffer()
+	dec.data = nil
 	streamDecPool.Put(dec)
 }
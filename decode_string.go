@@ -11,6 +11,7 @@ func (dec *Decoder) DecodeString(v *string) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeString(v)
 }
 func (dec *Decoder) decodeString(v *string) error {
@@ -27,6 +28,7 @@ func (dec *Decoder) decodeString(v *string) error {
 			}
 			// we do minus one to remove the last quote
 			d := dec.data[start : end-1]
+			dec.aliased = 1
 			*v = *(*string)(unsafe.Pointer(&d))
 			dec.cursor = end
 			return nil
@@ -68,6 +70,7 @@ func (dec *Decoder) decodeStringNull(v **string) error {
 			}
 			// we do minus one to remove the last quote
 			d := dec.data[start : end-1]
+			dec.aliased = 1
 			**v = *(*string)(unsafe.Pointer(&d))
 			dec.cursor = end
 			return nil
@@ -164,61 +167,56 @@ func (dec *Decoder) getString() (int, int, error) {
 	return 0, 0, dec.raiseInvalidJSONErr(dec.cursor)
 }
 
+// skipEscapedString skips an escape sequence, the cursor must be right after its backslash.
 func (dec *Decoder) skipEscapedString() error {
-	start := dec.cursor
-	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
-		if dec.data[dec.cursor] != '\\' {
-			d := dec.data[dec.cursor]
-			dec.cursor = dec.cursor + 1
-			nSlash := dec.cursor - start
-			switch d {
-			case '"':
-				// nSlash must be odd
-				if nSlash&1 != 1 {
-					return dec.raiseInvalidJSONErr(dec.cursor)
-				}
-				return nil
-			case 'u': // is unicode, we skip the following characters and place the cursor one one byte backward to avoid it breaking when returning to skipString
-				if err := dec.skipString(); err != nil {
-					return err
-				}
-				dec.cursor--
-				return nil
-			case 'n', 'r', 't', '/', 'f', 'b':
-				return nil
-			default:
-				// nSlash must be even
-				if nSlash&1 == 1 {
-					return dec.raiseInvalidJSONErr(dec.cursor)
-				}
-				return nil
+	if dec.cursor >= dec.length && !dec.read() {
+		return dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	switch dec.data[dec.cursor] {
+	case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+		dec.cursor++
+		return nil
+	case 'u':
+		for i := 0; i < 4; i++ {
+			dec.cursor++
+			if dec.cursor >= dec.length && !dec.read() {
+				return dec.raiseInvalidJSONErr(dec.cursor)
+			}
+			if !isHexDigit(dec.data[dec.cursor]) {
+				return dec.raiseInvalidJSONErr(dec.cursor)
 			}
 		}
+		dec.cursor++
+		return nil
 	}
 	return dec.raiseInvalidJSONErr(dec.cursor)
 }
 
+// skipString skips a string, the cursor must be right after its opening quote.
+// Control chars must be escaped and escape sequences must be valid.
 func (dec *Decoder) skipString() error {
 	for dec.cursor < dec.length || dec.read() {
-		switch dec.data[dec.cursor] {
+		switch c := dec.data[dec.cursor]; {
 		// found the closing quote
 		// let's return
-		case '"':
+		case c == '"':
 			dec.cursor = dec.cursor + 1
 			return nil
 		// solidus found start parsing an escaped string
-		case '\\':
+		case c == '\\':
 			dec.cursor = dec.cursor + 1
 			err := dec.skipEscapedString()
 			if err != nil {
 				return err
 			}
+		case c < 0x20:
+			// control chars must be escaped
+			return dec.raiseInvalidJSONErr(dec.cursor)
 		default:
 			dec.cursor = dec.cursor + 1
-			continue
 		}
 	}
-	return dec.raiseInvalidJSONErr(len(dec.data) - 1)
+	return dec.raiseInvalidJSONErr(dec.cursor)
 }
 
 // Add Values functions
@@ -689,7 +689,7 @@ func TestDecoderSkipEscapedStringError(t *testing.T) {
 func TestDecoderSkipEscapedStringError2(t *testing.T) {
 	dec := NewDecoder(strings.NewReader(`\"`))
 	defer dec.Release()
-	err := dec.skipEscapedString()
+	err := dec.skipString()
 	assert.NotNil(t, err, "Err must be nil")
 	assert.IsType(t, InvalidJSONError(""), err, "err must be of type InvalidJSONError")
 }
@@ -705,6 +705,30 @@ func TestDecoderSkipEscapedStringError3(t *testing.T) {
 func TestDecoderSkipEscapedStringError4(t *testing.T) {
 	dec := NewDecoder(strings.NewReader(`\u12`))
 	defer dec.Release()
+	err := dec.skipString()
+	assert.NotNil(t, err, "Err must be nil")
+	assert.IsType(t, InvalidJSONError(""), err, "err must be of type InvalidJSONError")
+}
+
+func TestDecoderSkipEscapedStringError5(t *testing.T) {
+	dec := NewDecoder(strings.NewReader(`uZZZZ"`))
+	defer dec.Release()
+	err := dec.skipEscapedString()
+	assert.NotNil(t, err, "Err must be nil")
+	assert.IsType(t, InvalidJSONError(""), err, "err must be of type InvalidJSONError")
+}
+
+func TestDecoderSkipEscapedStringError6(t *testing.T) {
+	dec := NewDecoder(strings.NewReader(`u12`))
+	defer dec.Release()
+	err := dec.skipEscapedString()
+	assert.NotNil(t, err, "Err must be nil")
+	assert.IsType(t, InvalidJSONError(""), err, "err must be of type InvalidJSONError")
+}
+
+func TestDecoderSkipEscapedStringError7(t *testing.T) {
+	dec := NewDecoder(strings.NewReader(`x"`))
+	defer dec.Release()
 	err := dec.skipEscapedString()
 	assert.NotNil(t, err, "Err must be nil")
 	assert.IsType(t, InvalidJSONError(""), err, "err must be of type InvalidJSONError")
@@ -727,9 +751,22 @@ func TestSkipString(t *testing.T) {
 		errType        interface{}
 	}{
 		{
-			name:           "escape quote err",
+			name:           "escaped backslash before closing quote",
 			json:           `test string \\" escaped"`,
 			expectedResult: ``,
+			err:            false,
+		},
+		{
+			name:           "unterminated after escaped quote",
+			json:           `test string \" escaped`,
+			expectedResult: ``,
+			err:            true,
+			errType:        InvalidJSONError(""),
+		},
+		{
+			name:           "control char",
+			json:           "test string \n escaped\"",
+			expectedResult: ``,
 			err:            true,
 			errType:        InvalidJSONError(""),
 		},
@@ -762,8 +799,8 @@ func TestSkipString(t *testing.T) {
 			if testCase.errType != nil {
 				assert.IsType(t, testCase.errType, err, "err should be of expected type")
 			}
-			return
+			continue
 		}
-		assert.Nil(t, err, "err should be nil")
+		assert.Nil(t, err, "err should be nil", testCase.name)
 	}
 }
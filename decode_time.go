@@ -9,6 +9,7 @@ func (dec *Decoder) DecodeTime(v *time.Time, format string) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeTime(v, format)
 }
 
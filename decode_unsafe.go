@@ -109,6 +109,11 @@ func (u decUnsafe) Unmarshal(data []byte, v interface{}) error {
 		dec.length = len(data)
 		dec.data = data
 		_, err = dec.decodeArray(vt)
+	case *interface{}:
+		dec = borrowDecoder(nil, 0)
+		dec.length = len(data)
+		dec.data = data
+		err = dec.decodeInterface(vt)
 	default:
 		return InvalidUnmarshalError(fmt.Sprintf(invalidUnmarshalErrorMsg, vt))
 	}
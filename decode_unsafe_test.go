@@ -269,6 +269,15 @@ func TestUnmarshalUnsafeAllTypes(t *testing.T) {
 				assert.Equal(t, "test2", vt[1].test, "vt[1].test must be equal to 'test2'")
 			},
 		},
+		{
+			v:    new(interface{}),
+			d:    []byte(`{"test":[1,"test"]}`),
+			name: "test decode interface",
+			expectations: func(err error, v interface{}, t *testing.T) {
+				assert.Nil(t, err, "err must be nil")
+				assert.Equal(t, map[string]interface{}{"test": []interface{}{float64(1), "test"}}, *v.(*interface{}), "v must be equal to the decoded object")
+			},
+		},
 		{
 			v:    new(struct{}),
 			d:    []byte(`{"test":"test"}`),
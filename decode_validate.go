@@ -0,0 +1,67 @@
+package gojay
+
+import "io"
+
+// Valid reports whether data is a valid JSON encoding.
+//
+// Valid strictly checks the grammar of the whole document, with the scanners skipping values while decoding.
+// It does not copy the input nor allocate memory.
+func Valid(data []byte) bool {
+	dec := borrowDecoder(nil, 0)
+	defer dec.Release()
+	dec.data = data
+	dec.length = len(data)
+	return dec.validate() == nil
+}
+
+// Validate reads a JSON document from r and checks its grammar without decoding it.
+//
+// If the document is not valid JSON, an InvalidJSONError giving the position of the faulty char is returned.
+func Validate(r io.Reader) error {
+	dec := BorrowDecoder(r)
+	defer dec.Release()
+	return dec.validate()
+}
+
+// Validate checks the grammar of the next JSON value from the decoder's input without decoding it.
+func (dec *Decoder) Validate() error {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	return dec.validate()
+}
+
+func (dec *Decoder) validate() error {
+	if err := dec.skipValue(); err != nil {
+		return err
+	}
+	// only white spaces are allowed after the value
+	if dec.nextNonSpace() != 0 {
+		return dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	return nil
+}
+
+// nextNonSpace returns the next char which is not a white space, contrary to nextChar it does not skip commas.
+func (dec *Decoder) nextNonSpace() byte {
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		switch dec.data[dec.cursor] {
+		case ' ', '\n', '\t', '\r':
+			continue
+		}
+		return dec.data[dec.cursor]
+	}
+	return 0
+}
+
+// skipValue skips the next JSON value, contrary to skipData it does not accept a leading comma.
+func (dec *Decoder) skipValue() error {
+	if dec.nextNonSpace() == ',' {
+		return dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	return dec.skipData()
+}
+
+func isHexDigit(b byte) bool {
+	return (b >= '0' && b <= '9') || (b >= 'a' && b <= 'f') || (b >= 'A' && b <= 'F')
+}
@@ -0,0 +1,126 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValid(t *testing.T) {
+	testCases := []struct {
+		name  string
+		json  string
+		valid bool
+	}{
+		{name: "object", json: `{"a":1,"b":[true,false,null],"c":{"d":"e"}}`, valid: true},
+		{name: "object-spaces", json: " \t\r\n{ \"a\" : 1 , \"b\" : [ ] , \"c\" : { } }\n", valid: true},
+		{name: "array", json: `[1,-2,3.5,-0.1e10,2E+3,1e-2,"str",{}]`, valid: true},
+		{name: "string-escapes", json: `"\"\\\/\b\f\n\r\té😀"`, valid: true},
+		{name: "true", json: `true`, valid: true},
+		{name: "false", json: `false`, valid: true},
+		{name: "null", json: "null\r\n", valid: true},
+		{name: "zero", json: `0`, valid: true},
+		{name: "empty", json: ``},
+		{name: "spaces-only", json: `   `},
+		{name: "trailing-comma-object", json: `{"a":1,}`},
+		{name: "trailing-comma-array", json: `[1,2,]`},
+		{name: "leading-comma-array", json: `[,1]`},
+		{name: "missing-comma", json: `[1 2]`},
+		{name: "missing-colon", json: `{"a" 1}`},
+		{name: "non-string-key", json: `{a:1}`},
+		{name: "unclosed-object", json: `{"a":1`},
+		{name: "unclosed-array", json: `[1,2`},
+		{name: "unclosed-string", json: `"abc`},
+		{name: "mismatched-brackets", json: `{"a":[1}]`},
+		{name: "invalid-escape", json: `"\x"`},
+		{name: "invalid-unicode-escape", json: `"\u12G4"`},
+		{name: "short-unicode-escape", json: `"\u12"`},
+		{name: "control-char", json: "\"a\nb\""},
+		{name: "leading-zero", json: `01`},
+		{name: "leading-plus", json: `+1`},
+		{name: "dot-no-digits", json: `1.`},
+		{name: "dot-no-int", json: `.5`},
+		{name: "exponent-no-digits", json: `1e+`},
+		{name: "minus-only", json: `-`},
+		{name: "bad-literal", json: `tru`},
+		{name: "bad-literal-2", json: `nul1`},
+		{name: "trailing-data", json: `{} {}`},
+		{name: "trailing-garbage", json: `1x`},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.valid, Valid([]byte(testCase.json)), "Valid should return the expected result")
+			err := Validate(strings.NewReader(testCase.json))
+			if testCase.valid {
+				assert.Nil(t, err, "err should be nil")
+				return
+			}
+			assert.NotNil(t, err, "err should not be nil")
+			assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		})
+	}
+}
+
+func TestValidDoesNotMutateInput(t *testing.T) {
+	json := []byte(`{"a":"\né"}`)
+	cp := string(json)
+	assert.True(t, Valid(json), "json should be valid")
+	assert.Equal(t, cp, string(json), "input should not be modified")
+}
+
+func TestValidateErrorPosition(t *testing.T) {
+	err := Validate(strings.NewReader(`{"a":[1,2,]}`))
+	assert.Equal(t, `Invalid JSON, wrong char ']' found at position 10`, err.Error(), "err message should give the position")
+}
+
+func TestDecoderValidate(t *testing.T) {
+	dec := NewDecoder(strings.NewReader(`[{"a":1},{"b":2}]`))
+	assert.Nil(t, dec.Validate(), "err should be nil")
+	dec = BorrowDecoder(strings.NewReader(`[{"a":1},{"b":2}]`))
+	dec.Release()
+	assert.Panics(t, func() {
+		dec.Validate()
+	}, "should panic when decoder is pooled")
+}
+
+func TestValidNoAlloc(t *testing.T) {
+	json := []byte(`{"a":1,"b":[true,false,null],"c":{"d":"eé"}}`)
+	allocs := testing.AllocsPerRun(100, func() {
+		Valid(json)
+	})
+	assert.Equal(t, float64(0), allocs, "Valid should not allocate")
+}
+
+func TestDecoderSkipStrict(t *testing.T) {
+	testCases := []struct {
+		name string
+		json string
+		err  bool
+	}{
+		{name: "valid", json: `{"skipped":{"a":[1,"b",null]},"a":1}`},
+		{name: "trailing-comma", json: `{"skipped":[1,2,],"a":1}`, err: true},
+		{name: "missing-colon", json: `{"skipped":{"b" 1},"a":1}`, err: true},
+		{name: "mismatched-brackets", json: `{"skipped":{"b":[1}],"a":1}`, err: true},
+		{name: "control-char", json: "{\"skipped\":\"a\nb\",\"a\":1}", err: true},
+		{name: "invalid-number", json: `{"skipped":01,"a":1}`, err: true},
+		{name: "invalid-escape", json: `{"skipped":"\x","a":1}`, err: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var a int
+			err := UnmarshalJSONObject([]byte(testCase.json), DecodeObjectFunc(func(dec *Decoder, k string) error {
+				if k == "a" {
+					return dec.Int(&a)
+				}
+				return nil
+			}))
+			if testCase.err {
+				assert.IsType(t, InvalidJSONError(""), err, "err should be an InvalidJSONError")
+				return
+			}
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, 1, a)
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package gojay
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Set returns a copy of data where the value referenced by the JSON Pointer (RFC 6901) is replaced by value.
+//
+// If the pointer references a missing key of an existing object, the key is added to the object.
+// If the pointer references the index right after the last element of an array, or is "-", the value is appended to the array.
+// Only the affected byte range is rewritten, the rest of the document is neither decoded nor re-encoded.
+//
+// value can be a MarshalerJSONObject, a MarshalerJSONArray, an EmbeddedJSON, nil or any type accepted by Marshal.
+func Set(data []byte, pointer string, value interface{}) ([]byte, error) {
+	return edit(data, pointer, value, editSet)
+}
+
+// Insert returns a copy of data where value is inserted at the location referenced by the JSON Pointer.
+//
+// Contrary to Set, when the pointer references an element of an array,
+// the value is inserted before that element instead of replacing it.
+// For objects, Insert behaves like Set.
+func Insert(data []byte, pointer string, value interface{}) ([]byte, error) {
+	return edit(data, pointer, value, editInsert)
+}
+
+// Delete returns a copy of data where the value referenced by the JSON Pointer is removed,
+// along with its key if it is an object member.
+func Delete(data []byte, pointer string) ([]byte, error) {
+	return edit(data, pointer, nil, editDelete)
+}
+
+const (
+	editSet = iota
+	editInsert
+	editDelete
+)
+
+// pointerTarget describes where the last reference token of a pointer lands in its parent container.
+type pointerTarget struct {
+	isArray bool
+	found   bool
+	// offsets of the member, for an object member start is the offset of its key
+	start      int
+	valueStart int
+	valueEnd   int
+	// end of the previous member's value, -1 if the member is the first one
+	prevEnd int
+	// start of the next member, -1 if the member is the last one
+	nextStart int
+	// offset of the closing bracket, set when the member is not found
+	closePos int
+}
+
+func edit(data []byte, pointer string, value interface{}, op int) ([]byte, error) {
+	if err := checkPointer(pointer); err != nil {
+		return nil, err
+	}
+	var b []byte
+	if op != editDelete {
+		var err error
+		b, err = marshalEditValue(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	dec := borrowDecoder(nil, 0)
+	defer dec.Release()
+	dec.data = data
+	dec.length = len(data)
+	// root document
+	if pointer == "" {
+		if op == editDelete {
+			return nil, InvalidPointerError("Cannot delete the root value")
+		}
+		start, end, err := dec.seekValueEnd()
+		if err != nil {
+			return nil, err
+		}
+		return splice(data, start, end, b), nil
+	}
+	i := strings.LastIndexByte(pointer, '/')
+	parent, token := pointer[:i], pointer[i+1:]
+	for parent != "" {
+		var t string
+		t, parent = nextPointerToken(parent)
+		if err := dec.seekToken(t); err != nil {
+			return nil, err
+		}
+	}
+	target, err := dec.seekTarget(token)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case op == editDelete && !target.found:
+		return nil, ErrPointerNotFound
+	case op == editDelete && target.nextStart >= 0:
+		return splice(data, target.start, target.nextStart, nil), nil
+	case op == editDelete && target.prevEnd >= 0:
+		return splice(data, target.prevEnd, target.valueEnd, nil), nil
+	case op == editDelete:
+		return splice(data, target.start, target.valueEnd, nil), nil
+	case target.found && (op == editSet || !target.isArray):
+		return splice(data, target.valueStart, target.valueEnd, b), nil
+	case target.found:
+		// insert before the referenced array element
+		return splice(data, target.start, target.start, append(b, ',')), nil
+	}
+	// add a new member at the end of the container
+	enc := BorrowEncoder(nil)
+	defer enc.Release()
+	pos := target.closePos
+	if target.prevEnd >= 0 {
+		pos = target.prevEnd
+		enc.writeByte(',')
+	}
+	if !target.isArray {
+		enc.writeByte('"')
+		enc.writeStringEscape(unescapePointerToken(token))
+		enc.writeTwoBytes('"', ':')
+	}
+	enc.writeBytes(b)
+	return splice(data, pos, pos, enc.buf), nil
+}
+
+// seekTarget looks for the member referenced by token in the object or array found at the cursor.
+func (dec *Decoder) seekTarget(token string) (pointerTarget, error) {
+	t := pointerTarget{prevEnd: -1, nextStart: -1}
+	var index int
+	switch dec.nextNonSpace() {
+	case '{':
+	case '[':
+		t.isArray = true
+		if token != "-" {
+			var ok bool
+			if index, ok = parsePointerIndex(token); !ok {
+				return t, ErrPointerNotFound
+			}
+		} else {
+			index = -1
+		}
+	case 0:
+		return t, dec.raiseInvalidJSONErr(dec.cursor)
+	default:
+		// scalar values can't be referenced into
+		return t, ErrPointerNotFound
+	}
+	dec.cursor++
+	for i := 0; ; i++ {
+		switch c := dec.nextNonSpace(); {
+		case c == '}' && !t.isArray, c == ']' && t.isArray:
+			if t.isArray && index >= 0 && index != i {
+				return t, ErrPointerNotFound
+			}
+			t.closePos = dec.cursor
+			return t, nil
+		case c == 0, c != '"' && !t.isArray:
+			return t, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		t.start = dec.cursor
+		match := i == index
+		if !t.isArray {
+			dec.cursor++
+			keyStart := dec.cursor
+			if err := dec.skipString(); err != nil {
+				return t, err
+			}
+			match = matchPointerToken(dec.data[keyStart:dec.cursor-1], token)
+			if dec.nextNonSpace() != ':' {
+				return t, dec.raiseInvalidJSONErr(dec.cursor)
+			}
+			dec.cursor++
+		}
+		valueStart, valueEnd, err := dec.seekValueEnd()
+		if err != nil {
+			return t, err
+		}
+		switch dec.nextNonSpace() {
+		case ',':
+			dec.cursor++
+			if match {
+				dec.nextNonSpace()
+				t.nextStart = dec.cursor
+			}
+		case '}', ']':
+		default:
+			return t, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		if match {
+			t.found = true
+			t.valueStart = valueStart
+			t.valueEnd = valueEnd
+			return t, nil
+		}
+		t.prevEnd = valueEnd
+	}
+}
+
+func marshalEditValue(value interface{}) ([]byte, error) {
+	switch vt := value.(type) {
+	case nil:
+		return []byte("null"), nil
+	case EmbeddedJSON:
+		return []byte(vt), nil
+	case *EmbeddedJSON:
+		if vt == nil {
+			return []byte("null"), nil
+		}
+		return []byte(*vt), nil
+	case MarshalerJSONObject, MarshalerJSONArray, string, bool,
+		int, int64, int32, int16, int8, uint64, uint32, uint16, uint8, float64, float32:
+		return Marshal(vt)
+	default:
+		return nil, InvalidMarshalError(fmt.Sprintf(invalidMarshalErrorMsg, vt))
+	}
+}
+
+// unescapePointerToken replaces the ~1 and ~0 escape sequences of a reference token.
+func unescapePointerToken(token string) string {
+	if strings.IndexByte(token, '~') < 0 {
+		return token
+	}
+	return strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+}
+
+// splice returns a copy of data where the range [start:end] is replaced by b.
+func splice(data []byte, start, end int, b []byte) []byte {
+	out := make([]byte, 0, len(data)-(end-start)+len(b))
+	out = append(out, data[:start]...)
+	out = append(out, b...)
+	return append(out, data[end:]...)
+}
@@ -0,0 +1,138 @@
+package gojay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type editTestObject struct {
+	id   int
+	name string
+}
+
+func (o *editTestObject) MarshalJSONObject(enc *Encoder) {
+	enc.IntKey("id", o.id)
+	enc.StringKey("name", o.name)
+}
+
+func (o *editTestObject) IsNil() bool {
+	return o == nil
+}
+
+func TestSet(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		pointer  string
+		value    interface{}
+		expected string
+		err      error
+	}{
+		{name: "replace-string", json: `{"a":"b","c":1}`, pointer: "/a", value: "x", expected: `{"a":"x","c":1}`},
+		{name: "replace-keeps-spaces", json: `{ "a" : 1 , "c" : 2 }`, pointer: "/c", value: 3, expected: `{ "a" : 1 , "c" : 3 }`},
+		{name: "replace-object", json: `{"auth":{"token":"secret","user":"u"}}`, pointer: "/auth/token", value: "***", expected: `{"auth":{"token":"***","user":"u"}}`},
+		{name: "replace-with-object", json: `{"a":null}`, pointer: "/a", value: &editTestObject{1, "n"}, expected: `{"a":{"id":1,"name":"n"}}`},
+		{name: "replace-with-embedded", json: `{"a":[1,2]}`, pointer: "/a", value: EmbeddedJSON(`{"b":true}`), expected: `{"a":{"b":true}}`},
+		{name: "replace-with-embedded-ptr", json: `{"a":[1,2]}`, pointer: "/a", value: &EmbeddedJSON{'3'}, expected: `{"a":3}`},
+		{name: "replace-with-nil", json: `{"a":[1,2]}`, pointer: "/a", value: nil, expected: `{"a":null}`},
+		{name: "replace-bool", json: `{"a":false}`, pointer: "/a", value: true, expected: `{"a":true}`},
+		{name: "replace-float", json: `{"a":1}`, pointer: "/a", value: 1.5, expected: `{"a":1.5}`},
+		{name: "replace-array-element", json: `[1,2,3]`, pointer: "/1", value: "x", expected: `[1,"x",3]`},
+		{name: "replace-root", json: ` {"a":1} `, pointer: "", value: 1, expected: ` 1 `},
+		{name: "add-key", json: `{"a":1}`, pointer: "/trace_id", value: "abc", expected: `{"a":1,"trace_id":"abc"}`},
+		{name: "add-key-empty-object", json: `{ }`, pointer: "/a", value: 1, expected: `{ "a":1}`},
+		{name: "add-key-escaped", json: `{}`, pointer: "/a~1b\"", value: 1, expected: `{"a/b\"":1}`},
+		{name: "add-nested-key", json: `{"a":{"b":1}}`, pointer: "/a/c", value: 2, expected: `{"a":{"b":1,"c":2}}`},
+		{name: "append-index", json: `[1,2]`, pointer: "/2", value: 3, expected: `[1,2,3]`},
+		{name: "append-dash", json: `{"a":[]}`, pointer: "/a/-", value: 3, expected: `{"a":[3]}`},
+		{name: "index-out-of-range", json: `[1,2]`, pointer: "/3", value: 3, err: ErrPointerNotFound},
+		{name: "missing-parent", json: `{"a":1}`, pointer: "/b/c", value: 3, err: ErrPointerNotFound},
+		{name: "scalar-parent", json: `{"a":1}`, pointer: "/a/c", value: 3, err: ErrPointerNotFound},
+		{name: "invalid-pointer", json: `{"a":1}`, pointer: "a", value: 3, err: InvalidPointerError("JSON Pointer must be empty or start with '/'")},
+		{name: "invalid-value", json: `{"a":1}`, pointer: "/a", value: struct{}{}, err: InvalidMarshalError("Invalid type struct {} provided to Marshal")},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			json := []byte(testCase.json)
+			b, err := Set(json, testCase.pointer, testCase.value)
+			if testCase.err != nil {
+				assert.Equal(t, testCase.err, err, "err should be the expected one")
+				return
+			}
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, string(b), "result should be the expected one")
+			assert.Equal(t, testCase.json, string(json), "input should not be modified")
+		})
+	}
+}
+
+func TestInsert(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		pointer  string
+		value    interface{}
+		expected string
+		err      error
+	}{
+		{name: "insert-first", json: `[1,2]`, pointer: "/0", value: 0, expected: `[0,1,2]`},
+		{name: "insert-middle", json: `[1, 3]`, pointer: "/1", value: 2, expected: `[1, 2,3]`},
+		{name: "insert-end", json: `[1,2]`, pointer: "/2", value: 3, expected: `[1,2,3]`},
+		{name: "insert-dash", json: `[]`, pointer: "/-", value: 3, expected: `[3]`},
+		{name: "insert-object-key", json: `{"a":1}`, pointer: "/a", value: 2, expected: `{"a":2}`},
+		{name: "insert-out-of-range", json: `[1]`, pointer: "/2", value: 2, err: ErrPointerNotFound},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			b, err := Insert([]byte(testCase.json), testCase.pointer, testCase.value)
+			if testCase.err != nil {
+				assert.Equal(t, testCase.err, err, "err should be the expected one")
+				return
+			}
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, string(b), "result should be the expected one")
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		pointer  string
+		expected string
+		err      error
+	}{
+		{name: "delete-first-key", json: `{"a":1, "b":2, "c":3}`, pointer: "/a", expected: `{"b":2, "c":3}`},
+		{name: "delete-middle-key", json: `{"a":1, "b":2, "c":3}`, pointer: "/b", expected: `{"a":1, "c":3}`},
+		{name: "delete-last-key", json: `{"a":1, "b":2, "c":3}`, pointer: "/c", expected: `{"a":1, "b":2}`},
+		{name: "delete-only-key", json: `{ "a":{"b":[1]} }`, pointer: "/a", expected: `{  }`},
+		{name: "delete-nested-key", json: `{"auth":{"token":"secret","user":"u"}}`, pointer: "/auth/token", expected: `{"auth":{"user":"u"}}`},
+		{name: "delete-array-element", json: `[1,2,3]`, pointer: "/1", expected: `[1,3]`},
+		{name: "delete-last-array-element", json: `[1,2,3]`, pointer: "/2", expected: `[1,2]`},
+		{name: "delete-missing-key", json: `{"a":1}`, pointer: "/b", err: ErrPointerNotFound},
+		{name: "delete-dash", json: `[1]`, pointer: "/-", err: ErrPointerNotFound},
+		{name: "delete-root", json: `[1]`, pointer: "", err: InvalidPointerError("Cannot delete the root value")},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			b, err := Delete([]byte(testCase.json), testCase.pointer)
+			if testCase.err != nil {
+				assert.Equal(t, testCase.err, err, "err should be the expected one")
+				return
+			}
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, string(b), "result should be the expected one")
+		})
+	}
+}
+
+func TestEditInvalidJSON(t *testing.T) {
+	_, err := Set([]byte(`{"a":1,"b"}`), "/c", 1)
+	assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+	_, err = Delete([]byte(`[1,2`), "/3")
+	assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+	_, err = Delete([]byte(`{1:2}`), "/1")
+	assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+}
@@ -75,6 +75,36 @@ func MarshalJSONObject(v MarshalerJSONObject) ([]byte, error) {
 	return enc.encodeObject(v)
 }
 
+// AppendObject appends the JSON encoding of v, an implementation of MarshalerJSONObject, to dst and returns the extended buffer.
+//
+// Unlike MarshalJSONObject, the caller owns the buffer, which can be reused so that encoding does not allocate:
+//	buf = gojay.AppendObject(buf[:0], v)
+// Encoding errors are not reported, use an Encoder with Reset to get them.
+func AppendObject(dst []byte, v MarshalerJSONObject) []byte {
+	enc := BorrowEncoder(nil)
+	var buf = enc.buf
+	enc.buf = dst
+	dst, _ = enc.encodeObject(v)
+	enc.buf = buf
+	enc.Release()
+	return dst
+}
+
+// AppendArray appends the JSON encoding of v, an implementation of MarshalerJSONArray, to dst and returns the extended buffer.
+//
+// Unlike MarshalJSONArray, the caller owns the buffer, which can be reused so that encoding does not allocate:
+//	buf = gojay.AppendArray(buf[:0], v)
+// Encoding errors are not reported, use an Encoder with Reset to get them.
+func AppendArray(dst []byte, v MarshalerJSONArray) []byte {
+	enc := BorrowEncoder(nil)
+	var buf = enc.buf
+	enc.buf = dst
+	dst, _ = enc.encodeArray(v)
+	enc.buf = buf
+	enc.Release()
+	return dst
+}
+
 // Marshal returns the JSON encoding of v.
 //
 // If v is nil, not an implementation MarshalerJSONObject or MarshalerJSONArray or not one of the following types:
@@ -166,12 +196,22 @@ type MarshalerJSONArray interface {
 
 // An Encoder writes JSON values to an output stream.
 type Encoder struct {
-	buf      []byte
-	isPooled byte
-	w        io.Writer
-	err      error
-	hasKeys  bool
-	keys     []string
+	buf           []byte
+	isPooled      byte
+	w             io.Writer
+	err           error
+	hasKeys       bool
+	keys          []string
+	mask          *FieldMask // fields selected in the object being encoded
+	redaction     *Redaction
+	redact        *redactNode // keys redacted in the object being encoded
+	highWaterMark int
+	lastFlushed   byte  // last byte written to w, 0 if nothing was written
+	writeErr      error // error writing to w while encoding, the rest of the document is discarded
+
+	quotedIntegers bool
+	fixedFloats    bool
+	floatPrecision int
 }
 
 // AppendBytes allows a modular usage by appending bytes manually to the current state of the buffer.
@@ -189,14 +229,91 @@ func (enc *Encoder) Buf() []byte {
 	return enc.buf
 }
 
+// Reset makes the encoder append the values it encodes to dst, discarding its buffer, its error and its keys.
+//
+// Without an io.Writer, the Encode methods keep the encoded bytes in the buffer returned by Buf,
+// so that a buffer owned by the caller can be reused without allocation:
+//	enc.Reset(buf[:0])
+//	err := enc.EncodeObject(v)
+//	buf = enc.Buf()
+func (enc *Encoder) Reset(dst []byte) {
+	enc.buf = dst
+	enc.err = nil
+	enc.writeErr = nil
+	enc.hasKeys = false
+	enc.keys = nil
+	enc.mask = nil
+	enc.lastFlushed = 0
+}
+
 // Write writes to the io.Writer and resets the buffer.
+// Without an io.Writer, the buffer is kept.
 func (enc *Encoder) Write() (int, error) {
+	if enc.w == nil {
+		return len(enc.buf), nil
+	}
+	if len(enc.buf) > 0 {
+		enc.lastFlushed = enc.buf[len(enc.buf)-1]
+	}
 	i, err := enc.w.Write(enc.buf)
 	enc.buf = enc.buf[:0]
 	return i, err
 }
 
+// SetHighWaterMark makes the encoder write the bytes encoded so far to its io.Writer whenever its buffer grows past n bytes,
+// including within the MarshalJSONObject and MarshalJSONArray calls of a document,
+// so that a document larger than memory can be encoded with a bounded buffer.
+// Zero, the default, keeps the whole document in the buffer until it is encoded.
+func (enc *Encoder) SetHighWaterMark(n int) {
+	enc.highWaterMark = n
+}
+
+// SetQuotedIntegers makes the encoder write integers quoted in strings, i.e. "123",
+// as JavaScript numbers lose precision on int64 values above 2^53.
+// The *String methods, i.e. Int64KeyString, write a quoted integer whatever the option.
+func (enc *Encoder) SetQuotedIntegers(on bool) {
+	enc.quotedIntegers = on
+}
+
+// SetFloatPrecision makes the encoder write floats with digits decimal places, i.e. 12.50 for 2 digits,
+// instead of their shortest representation. A negative digits restores the shortest representation.
+// FloatKeyPrecision sets the decimal places of a single float.
+func (enc *Encoder) SetFloatPrecision(digits int) {
+	enc.fixedFloats = digits >= 0
+	enc.floatPrecision = digits
+}
+
+// SetError reports an error from a MarshalJSONObject or MarshalJSONArray implementation, which cannot return one,
+// i.e. a value which cannot be encoded. The Encode and Marshal functions return the first error reported.
+func (enc *Encoder) SetError(err error) {
+	if enc.err == nil {
+		enc.err = err
+	}
+}
+
+// flush writes the buffer to the io.Writer once it has grown past the high water mark,
+// a write error is kept and returned when the document is encoded.
+// Once a write failed, the rest of the document is discarded instead of written so that the buffer stays bounded.
+func (enc *Encoder) flush() {
+	if enc.w == nil || len(enc.buf) < enc.highWaterMark {
+		return
+	}
+	if enc.writeErr != nil {
+		enc.lastFlushed = enc.buf[len(enc.buf)-1]
+		enc.buf = enc.buf[:0]
+		return
+	}
+	if _, err := enc.Write(); err != nil {
+		enc.err = err
+		enc.writeErr = err
+	}
+}
+
 func (enc *Encoder) getPreviousRune() byte {
 	last := len(enc.buf) - 1
+	if last < 0 && enc.lastFlushed != 0 {
+		// the buffer was flushed, the previous rune is the last one written
+		return enc.lastFlushed
+	}
 	return enc.buf[last]
 }
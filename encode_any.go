@@ -0,0 +1,90 @@
+package gojay
+
+// addAny adds a value to be encoded, must be used inside a slice or array encoding (does not encode a key).
+//
+// v can be any value accepted by AddInterface or a pointer to a builtin type,
+// it is meant for generic code where the type of v is only known at run time. A nil pointer is encoded as null.
+func (enc *Encoder) addAny(v interface{}) {
+	value, ok := anyValue(v)
+	if !ok {
+		enc.Null()
+		return
+	}
+	enc.AddInterface(value)
+}
+
+// addAnyKey adds a value to be encoded, must be used inside an object as it will encode a key.
+//
+// v can be any value accepted by AddInterfaceKey or a pointer to a builtin type,
+// it is meant for generic code where the type of v is only known at run time. A nil pointer is encoded as null.
+func (enc *Encoder) addAnyKey(key string, v interface{}) {
+	value, ok := anyValue(v)
+	if !ok {
+		enc.NullKey(key)
+		return
+	}
+	enc.AddInterfaceKey(key, value)
+}
+
+// anyValue dereferences pointers to builtin types, it returns false for nil pointers.
+func anyValue(v interface{}) (interface{}, bool) {
+	switch vt := v.(type) {
+	case *string:
+		if vt != nil {
+			return *vt, true
+		}
+	case *bool:
+		if vt != nil {
+			return *vt, true
+		}
+	case *int:
+		if vt != nil {
+			return *vt, true
+		}
+	case *int8:
+		if vt != nil {
+			return *vt, true
+		}
+	case *int16:
+		if vt != nil {
+			return int(*vt), true
+		}
+	case *int32:
+		if vt != nil {
+			return *vt, true
+		}
+	case *int64:
+		if vt != nil {
+			return *vt, true
+		}
+	case *uint8:
+		if vt != nil {
+			return *vt, true
+		}
+	case *uint16:
+		if vt != nil {
+			return *vt, true
+		}
+	case *uint32:
+		if vt != nil {
+			return *vt, true
+		}
+	case *uint64:
+		if vt != nil {
+			return *vt, true
+		}
+	case *float32:
+		if vt != nil {
+			return *vt, true
+		}
+	case *float64:
+		if vt != nil {
+			return *vt, true
+		}
+	case int16:
+		return int(vt), true
+	default:
+		return v, true
+	}
+	return nil, false
+}
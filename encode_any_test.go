@@ -0,0 +1,65 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testEncodeAnyObject struct {
+	name  *string
+	value interface{}
+}
+
+func (o *testEncodeAnyObject) MarshalJSONObject(enc *Encoder) {
+	enc.addAnyKey("name", o.name)
+	enc.addAnyKey("value", o.value)
+}
+
+func (o *testEncodeAnyObject) IsNil() bool {
+	return o == nil
+}
+
+func TestEncoderAny(t *testing.T) {
+	var s = "foo"
+	var i16 = int16(3)
+	var u64 = uint64(4)
+	var f32 = float32(1.5)
+	var b = true
+	testCases := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{name: "string", value: "foo", expected: `"foo"`},
+		{name: "string-ptr", value: &s, expected: `"foo"`},
+		{name: "string-nil", value: (*string)(nil), expected: `null`},
+		{name: "int16", value: int16(2), expected: `2`},
+		{name: "int16-ptr", value: &i16, expected: `3`},
+		{name: "uint64-ptr", value: &u64, expected: `4`},
+		{name: "float32-ptr", value: &f32, expected: `1.5`},
+		{name: "bool-ptr", value: &b, expected: `true`},
+		{name: "array", value: TestEncodingArrStrings{"a", "b"}, expected: `["a","b"]`},
+		{name: "object", value: &testEncodeAnyObject{name: &s, value: 1}, expected: `{"name":"foo","value":1}`},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			builder := &strings.Builder{}
+			enc := NewEncoder(builder)
+			err := enc.EncodeArray(EncodeArrayFunc(func(enc *Encoder) {
+				enc.addAny(testCase.value)
+			}))
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, "["+testCase.expected+"]", builder.String())
+		})
+	}
+}
+
+func TestEncoderAnyKey(t *testing.T) {
+	var i = 3
+	obj := &testEncodeAnyObject{value: &i}
+	b, err := MarshalJSONObject(obj)
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"name":null,"value":3}`, string(b))
+}
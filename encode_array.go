@@ -6,6 +6,11 @@ func (enc *Encoder) EncodeArray(v MarshalerJSONArray) error {
 		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
 	}
 	_, _ = enc.encodeArray(v)
+	if enc.writeErr != nil {
+		// the errors of the elements are ignored, not the ones writing the document
+		enc.buf = enc.buf[:0]
+		return enc.writeErr
+	}
 	_, err := enc.Write()
 	if err != nil {
 		enc.err = err
@@ -141,7 +146,13 @@ func (enc *Encoder) ArrayKey(key string, v MarshalerJSONArray) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKeyArr)
+	var origMask = enc.mask
+	var origRedact = enc.redact
+	enc.mask = enc.mask.child(key)
+	enc.redact = enc.redaction.child(enc.redact, key)
 	v.MarshalJSONArray(enc)
+	enc.mask = origMask
+	enc.redact = origRedact
 	enc.writeByte(']')
 }
 
@@ -164,7 +175,13 @@ func (enc *Encoder) ArrayKeyOmitEmpty(key string, v MarshalerJSONArray) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKeyArr)
+	var origMask = enc.mask
+	var origRedact = enc.redact
+	enc.mask = enc.mask.child(key)
+	enc.redact = enc.redaction.child(enc.redact, key)
 	v.MarshalJSONArray(enc)
+	enc.mask = origMask
+	enc.redact = origRedact
 	enc.writeByte(']')
 }
 
@@ -188,7 +205,13 @@ func (enc *Encoder) ArrayKeyNullEmpty(key string, v MarshalerJSONArray) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKeyArr)
+	var origMask = enc.mask
+	var origRedact = enc.redact
+	enc.mask = enc.mask.child(key)
+	enc.redact = enc.redaction.child(enc.redact, key)
 	v.MarshalJSONArray(enc)
+	enc.mask = origMask
+	enc.redact = origRedact
 	enc.writeByte(']')
 }
 
@@ -6,6 +6,9 @@ const hex = "0123456789abcdef"
 // another n bytes. After grow(n), at least n bytes can be written to b
 // without another allocation. If n is negative, grow panics.
 func (enc *Encoder) grow(n int) {
+	if enc.highWaterMark > 0 {
+		enc.flush()
+	}
 	if cap(enc.buf)-len(enc.buf) < n {
 		Buf := make([]byte, len(enc.buf), 2*cap(enc.buf)+n)
 		copy(Buf, enc.buf)
@@ -0,0 +1,100 @@
+package gojay
+
+import "fmt"
+
+// Decimal is the interface to implement by decimal number types, i.e. a shopspring/decimal wrapper,
+// to encode their exact digits without converting them to float64.
+//
+// AppendDecimal appends the JSON number of the decimal to b and returns the extended buffer, i.e. 12.50.
+// The encoder writes the appended bytes as is, therefore they must be a valid JSON number.
+// A decimal for which IsNil returns true is encoded as null.
+type Decimal interface {
+	AppendDecimal(b []byte) []byte
+	IsNil() bool
+}
+
+// EncodeDecimal encodes a Decimal to JSON
+func (enc *Encoder) EncodeDecimal(v Decimal) error {
+	if enc.isPooled == 1 {
+		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
+	}
+	_, err := enc.encodeDecimal(v)
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	_, err = enc.Write()
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	return nil
+}
+
+func (enc *Encoder) encodeDecimal(v Decimal) ([]byte, error) {
+	if v == nil || v.IsNil() {
+		enc.writeBytes(nullBytes)
+		return enc.buf, enc.err
+	}
+	var n = len(enc.buf)
+	enc.buf = v.AppendDecimal(enc.buf)
+	if len(enc.buf) == n {
+		enc.SetError(InvalidMarshalError(fmt.Sprintf("Invalid decimal %T: AppendDecimal appended no digits", v)))
+	}
+	return enc.buf, enc.err
+}
+
+// AddDecimal adds a Decimal to be encoded, must be used inside a slice or array encoding (does not encode a key)
+func (enc *Encoder) AddDecimal(v Decimal) {
+	enc.Decimal(v)
+}
+
+// AddDecimalKey adds a Decimal to be encoded, must be used inside an object as it will encode a key
+func (enc *Encoder) AddDecimalKey(key string, v Decimal) {
+	enc.DecimalKey(key, v)
+}
+
+// Decimal adds a Decimal to be encoded, must be used inside a slice or array encoding (does not encode a key).
+// A nil Decimal, or one for which IsNil returns true, is encoded as null.
+func (enc *Encoder) Decimal(v Decimal) {
+	if enc.redact == redactAll && v != nil && !v.IsNil() {
+		enc.writeRedactedValue(v.AppendDecimal(nil))
+		return
+	}
+	enc.grow(10)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	_, _ = enc.encodeDecimal(v)
+}
+
+// DecimalKey adds a Decimal to be encoded, must be used inside an object as it will encode a key.
+// A nil Decimal, or one for which IsNil returns true, is encoded as null.
+func (enc *Encoder) DecimalKey(key string, v Decimal) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	if enc.redaction != nil && enc.redactDecimal(key, v) {
+		return
+	}
+	enc.grow(10 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeBytes(objKey)
+	_, _ = enc.encodeDecimal(v)
+}
+
+func (enc *Encoder) redactDecimal(key string, v Decimal) bool {
+	if v == nil || v.IsNil() || enc.redaction.child(enc.redact, key) != redactAll {
+		return false
+	}
+	enc.writeRedacted(key, v.AppendDecimal(nil))
+	return true
+}
@@ -0,0 +1,163 @@
+package gojay
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testDecimal holds the exact digits of a decimal, as a decimal type would
+type testDecimal string
+
+func (d testDecimal) AppendDecimal(b []byte) []byte {
+	return append(b, d...)
+}
+
+func (d testDecimal) IsNil() bool {
+	return false
+}
+
+// testMoney is a decimal implemented on a pointer, which can be a typed nil
+type testMoney struct {
+	cents int64
+}
+
+func (m *testMoney) AppendDecimal(b []byte) []byte {
+	b = strconv.AppendInt(b, m.cents/100, 10)
+	return append(b, '.', byte('0'+m.cents%100/10), byte('0'+m.cents%10))
+}
+
+func (m *testMoney) IsNil() bool {
+	return m == nil
+}
+
+func TestEncoderDecimal(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    Decimal
+		expected string
+	}{
+		{
+			name:     "exact-digits",
+			value:    testDecimal("12.50"),
+			expected: `{"price":12.50,"prices":[12.50,0.10]}`,
+		},
+		{
+			name:     "beyond-float64",
+			value:    testDecimal("12345678901234567890.123456789"),
+			expected: `{"price":12345678901234567890.123456789,"prices":[12345678901234567890.123456789,0.10]}`,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: `{"price":null,"prices":[null,0.10]}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var b strings.Builder
+			enc := NewEncoder(&b)
+			err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+				enc.DecimalKey("price", testCase.value)
+				enc.ArrayKey("prices", EncodeArrayFunc(func(enc *Encoder) {
+					enc.Decimal(testCase.value)
+					enc.AddDecimal(testDecimal("0.10"))
+				}))
+			}))
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, b.String())
+		})
+	}
+}
+
+func TestEncoderDecimalKeys(t *testing.T) {
+	var b strings.Builder
+	enc := NewEncoder(&b)
+	err := enc.EncodeObjectMask(EncodeObjectFunc(func(enc *Encoder) {
+		enc.AddDecimalKey("price", testDecimal("1.00"))
+		enc.DecimalKey("cost", testDecimal("0.50"))
+	}), NewFieldMask("price"))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"price":1.00}`, b.String())
+}
+
+func TestEncoderDecimalRedaction(t *testing.T) {
+	var b strings.Builder
+	enc := NewEncoder(&b)
+	enc.SetRedaction(NewRedaction("salary"))
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.DecimalKey("salary", testDecimal("1000.00"))
+		enc.DecimalKey("bonus", testDecimal("10.00"))
+	}))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"salary":"***","bonus":10.00}`, b.String())
+}
+
+func TestEncoderDecimalInterface(t *testing.T) {
+	t.Run("encode", func(t *testing.T) {
+		var b strings.Builder
+		enc := NewEncoder(&b)
+		err := enc.Encode(testDecimal("1.10"))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, `1.10`, b.String())
+	})
+	t.Run("add-interface", func(t *testing.T) {
+		var b strings.Builder
+		enc := NewEncoder(&b)
+		err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+			enc.AddInterfaceKey("price", testDecimal("1.10"))
+			enc.AddInterfaceKeyOmitEmpty("cost", testDecimal("2.20"))
+			enc.ArrayKey("prices", EncodeArrayFunc(func(enc *Encoder) {
+				enc.AddInterface(testDecimal("3.30"))
+			}))
+		}))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, `{"price":1.10,"cost":2.20,"prices":[3.30]}`, b.String())
+	})
+	t.Run("typed-nil", func(t *testing.T) {
+		var b strings.Builder
+		enc := NewEncoder(&b)
+		enc.SetRedaction(NewRedaction("cost"))
+		err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+			enc.DecimalKey("price", (*testMoney)(nil))
+			enc.DecimalKey("cost", (*testMoney)(nil))
+			enc.DecimalKey("total", &testMoney{cents: 1250})
+			enc.ArrayKey("prices", EncodeArrayFunc(func(enc *Encoder) {
+				enc.Decimal((*testMoney)(nil))
+			}))
+		}))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, `{"price":null,"cost":null,"total":12.50,"prices":[null]}`, b.String())
+	})
+	t.Run("no-digits-error", func(t *testing.T) {
+		var b strings.Builder
+		enc := NewEncoder(&b)
+		err := enc.EncodeDecimal(testDecimal(""))
+		assert.IsType(t, InvalidMarshalError(""), err, "err should be of type InvalidMarshalError")
+		assert.Empty(t, b.String(), "nothing should be written")
+		_, err = Marshal(EncodeObjectFunc(func(enc *Encoder) {
+			enc.DecimalKey("price", testDecimal(""))
+		}))
+		assert.IsType(t, InvalidMarshalError(""), err, "err should be of type InvalidMarshalError")
+	})
+	t.Run("write-error", func(t *testing.T) {
+		w := TestWriterError("")
+		enc := NewEncoder(w)
+		err := enc.EncodeDecimal(testDecimal("1.10"))
+		assert.NotNil(t, err, "Error should not be nil")
+		assert.Equal(t, "Test Error", err.Error(), "err.Error() should be 'Test Error'")
+	})
+	t.Run("pool-error", func(t *testing.T) {
+		enc := BorrowEncoder(nil)
+		enc.isPooled = 1
+		defer func() {
+			err := recover()
+			assert.NotNil(t, err, "err shouldnt be nil")
+			assert.IsType(t, InvalidUsagePooledEncoderError(""), err, "err should be of type InvalidUsagePooledEncoderError")
+		}()
+		_ = enc.EncodeDecimal(testDecimal("1.10"))
+		assert.True(t, false, "should not be called as it should have panicked")
+	})
+}
@@ -24,6 +24,10 @@ func (enc *Encoder) encodeEmbeddedJSON(v *EmbeddedJSON) ([]byte, error) {
 // It basically blindly writes the bytes to the final buffer. Therefore,
 // it expects the JSON to be of proper format.
 func (enc *Encoder) AddEmbeddedJSON(v *EmbeddedJSON) {
+	if enc.redact == redactAll {
+		enc.writeRedactedValue(*v)
+		return
+	}
 	enc.grow(len(*v) + 4)
 	r := enc.getPreviousRune()
 	if r != '[' {
@@ -40,6 +44,10 @@ func (enc *Encoder) AddEmbeddedJSONOmitEmpty(v *EmbeddedJSON) {
 	if v == nil || len(*v) == 0 {
 		return
 	}
+	if enc.redact == redactAll {
+		enc.writeRedactedValue(*v)
+		return
+	}
 	r := enc.getPreviousRune()
 	if r != '[' {
 		enc.writeByte(',')
@@ -57,6 +65,9 @@ func (enc *Encoder) AddEmbeddedJSONKey(key string, v *EmbeddedJSON) {
 			return
 		}
 	}
+	if enc.redaction != nil && enc.redactEmbeddedJSON(key, v) {
+		return
+	}
 	enc.grow(len(key) + len(*v) + 5)
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -81,6 +92,9 @@ func (enc *Encoder) AddEmbeddedJSONKeyOmitEmpty(key string, v *EmbeddedJSON) {
 	if v == nil || len(*v) == 0 {
 		return
 	}
+	if enc.redaction != nil && enc.redactEmbeddedJSON(key, v) {
+		return
+	}
 	enc.grow(len(key) + len(*v) + 5)
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -91,3 +105,12 @@ func (enc *Encoder) AddEmbeddedJSONKeyOmitEmpty(key string, v *EmbeddedJSON) {
 	enc.writeBytes(objKey)
 	enc.writeBytes(*v)
 }
+
+// redactEmbeddedJSON replaces the whole embedded JSON of a redacted key, as its values cannot be told apart.
+func (enc *Encoder) redactEmbeddedJSON(key string, v *EmbeddedJSON) bool {
+	if enc.redaction.child(enc.redact, key) != redactAll {
+		return false
+	}
+	enc.writeRedacted(key, *v)
+	return true
+}
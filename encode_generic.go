@@ -0,0 +1,88 @@
+//go:build go1.18
+
+package gojay
+
+import "sort"
+
+// Slice is a slice implementing MarshalerJSONArray and UnmarshalerJSONArray for element types
+// which are builtin types or whose pointer implements the gojay interfaces, i.e. Slice[string] or Slice[User].
+// Use SliceOf to encode or decode an existing slice:
+//	dec.Array(gojay.SliceOf(&users))
+type Slice[T any] []T
+
+// SliceOf returns s as a *Slice[T], sharing its elements.
+func SliceOf[T any](s *[]T) *Slice[T] {
+	return (*Slice[T])(s)
+}
+
+// MarshalJSONArray implements MarshalerJSONArray.
+func (s Slice[T]) MarshalJSONArray(enc *Encoder) {
+	for i := range s {
+		addValue(enc, &s[i])
+	}
+}
+
+// IsNil implements MarshalerJSONArray.
+func (s Slice[T]) IsNil() bool {
+	return len(s) == 0
+}
+
+// Map is a map implementing MarshalerJSONObject and UnmarshalerJSONObject for value types
+// which are builtin types or whose pointer implements the gojay interfaces, i.e. Map[int] or Map[User].
+// Its keys are encoded in sorted order.
+// Use MapOf to encode or decode an existing map:
+//	dec.Object(gojay.MapOf(&counts))
+type Map[V any] map[string]V
+
+// MapOf returns m as a *Map[V], sharing its entries.
+func MapOf[V any](m *map[string]V) *Map[V] {
+	return (*Map[V])(m)
+}
+
+// MarshalJSONObject implements MarshalerJSONObject.
+func (m Map[V]) MarshalJSONObject(enc *Encoder) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := m[k]
+		addValueKey(enc, k, &v)
+	}
+}
+
+// IsNil implements MarshalerJSONObject.
+func (m Map[V]) IsNil() bool {
+	return m == nil
+}
+
+// Ptr returns a pointer to v, to set the pointer fields of the values to encode, i.e. Default: gojay.Ptr(42).
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// addValue adds the value pointed by v to be encoded, must be used inside a slice or array encoding (does not encode a key).
+// T is encoded as addAny encodes it, or as an object or an array if *T implements MarshalerJSONObject or MarshalerJSONArray.
+func addValue[T any](enc *Encoder, v *T) {
+	switch vt := any(v).(type) {
+	case MarshalerJSONObject:
+		enc.Object(vt)
+	case MarshalerJSONArray:
+		enc.Array(vt)
+	default:
+		enc.addAny(*v)
+	}
+}
+
+// addValueKey adds the value pointed by v to be encoded as addValue does, must be used inside an object as it will encode a key.
+func addValueKey[T any](enc *Encoder, key string, v *T) {
+	switch vt := any(v).(type) {
+	case MarshalerJSONObject:
+		enc.ObjectKey(key, vt)
+	case MarshalerJSONArray:
+		enc.ArrayKey(key, vt)
+	default:
+		enc.addAnyKey(key, *v)
+	}
+}
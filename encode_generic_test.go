@@ -0,0 +1,175 @@
+//go:build go1.18
+
+package gojay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testGenericUser struct {
+	id   int
+	name string
+}
+
+func (u *testGenericUser) MarshalJSONObject(enc *Encoder) {
+	enc.IntKey("id", u.id)
+	enc.StringKey("name", u.name)
+}
+
+func (u *testGenericUser) IsNil() bool {
+	return u == nil
+}
+
+func (u *testGenericUser) UnmarshalJSONObject(dec *Decoder, key string) error {
+	switch key {
+	case "id":
+		return dec.Int(&u.id)
+	case "name":
+		return dec.String(&u.name)
+	}
+	return nil
+}
+
+func (u *testGenericUser) NKeys() int {
+	return 2
+}
+
+func TestEncodeSlice(t *testing.T) {
+	testCases := []struct {
+		name     string
+		v        MarshalerJSONArray
+		expected string
+	}{
+		{
+			name:     "ints",
+			v:        Slice[int]{1, -2, 3},
+			expected: `[1,-2,3]`,
+		},
+		{
+			name:     "int16s",
+			v:        Slice[int16]{1, 2},
+			expected: `[1,2]`,
+		},
+		{
+			name:     "strings",
+			v:        Slice[string]{"a", `"b"`},
+			expected: `["a","\"b\""]`,
+		},
+		{
+			name:     "values",
+			v:        Slice[testGenericUser]{{id: 1, name: "a"}, {id: 2, name: "b"}},
+			expected: `[{"id":1,"name":"a"},{"id":2,"name":"b"}]`,
+		},
+		{
+			name:     "pointers",
+			v:        Slice[*testGenericUser]{{id: 1, name: "a"}},
+			expected: `[{"id":1,"name":"a"}]`,
+		},
+		{
+			name:     "pointers-to-builtins",
+			v:        Slice[*float64]{Ptr(1.5), nil},
+			expected: `[1.5,null]`,
+		},
+		{
+			name:     "nested",
+			v:        Slice[Slice[int]]{{1, 2}, {}, {3}},
+			expected: `[[1,2],[],[3]]`,
+		},
+		{
+			name:     "maps",
+			v:        Slice[Map[bool]]{{"b": true, "a": false}},
+			expected: `[{"a":false,"b":true}]`,
+		},
+		{
+			name:     "nil",
+			v:        Slice[int](nil),
+			expected: `[]`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var b strings.Builder
+			enc := NewEncoder(&b)
+			err := enc.EncodeArray(testCase.v)
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, b.String())
+		})
+	}
+}
+
+func TestEncodeSliceOf(t *testing.T) {
+	users := []testGenericUser{{id: 1, name: "a"}}
+	b, err := MarshalJSONArray(SliceOf(&users))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `[{"id":1,"name":"a"}]`, string(b))
+}
+
+func TestEncodeMap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		v        MarshalerJSONObject
+		expected string
+	}{
+		{
+			name:     "ints",
+			v:        Map[int]{"b": 2, "a": 1, "c": 3},
+			expected: `{"a":1,"b":2,"c":3}`,
+		},
+		{
+			name:     "values",
+			v:        Map[testGenericUser]{"u": {id: 1, name: "a"}},
+			expected: `{"u":{"id":1,"name":"a"}}`,
+		},
+		{
+			name:     "slices",
+			v:        Map[Slice[string]]{"tags": {"a", "b"}},
+			expected: `{"tags":["a","b"]}`,
+		},
+		{
+			name:     "pointers-to-builtins",
+			v:        Map[*string]{"a": Ptr("x"), "b": nil},
+			expected: `{"a":"x","b":null}`,
+		},
+		{
+			name:     "empty",
+			v:        Map[int]{},
+			expected: `{}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var b strings.Builder
+			enc := NewEncoder(&b)
+			err := enc.EncodeObject(testCase.v)
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, b.String())
+		})
+	}
+}
+
+func TestEncodeSliceKeys(t *testing.T) {
+	var b strings.Builder
+	enc := NewEncoder(&b)
+	counts := map[string]uint64{"x": 1}
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.ArrayKey("ids", Slice[int64]{1, 2})
+		enc.ArrayKeyOmitEmpty("omitted", Slice[int64]{})
+		enc.ObjectKey("counts", MapOf(&counts))
+		enc.ObjectKeyOmitEmpty("nil", Map[int](nil))
+	}))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"ids":[1,2],"counts":{"x":1}}`, b.String())
+}
+
+func TestEncodeSliceError(t *testing.T) {
+	var b strings.Builder
+	enc := NewEncoder(&b)
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.ArrayKey("invalid", Slice[struct{}]{{}})
+	}))
+	assert.NotNil(t, err, "err should not be nil")
+	assert.IsType(t, InvalidMarshalError(""), err, "err should be an InvalidMarshalError")
+}
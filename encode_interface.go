@@ -43,6 +43,8 @@ func (enc *Encoder) Encode(v interface{}) error {
 		return enc.EncodeFloat32(vt)
 	case *EmbeddedJSON:
 		return enc.EncodeEmbeddedJSON(vt)
+	case Decimal:
+		return enc.EncodeDecimal(vt)
 	default:
 		return InvalidMarshalError(fmt.Sprintf(invalidMarshalErrorMsg, vt))
 	}
@@ -79,6 +81,8 @@ func (enc *Encoder) AddInterface(value interface{}) {
 		enc.AddFloat(vt)
 	case float32:
 		enc.AddFloat32(vt)
+	case Decimal:
+		enc.AddDecimal(vt)
 	default:
 		if vt != nil {
 			enc.err = InvalidMarshalError(fmt.Sprintf(invalidMarshalErrorMsg, vt))
@@ -121,6 +125,8 @@ func (enc *Encoder) AddInterfaceKey(key string, value interface{}) {
 		enc.AddFloatKey(key, vt)
 	case float32:
 		enc.AddFloat32Key(key, vt)
+	case Decimal:
+		enc.AddDecimalKey(key, vt)
 	default:
 		if vt != nil {
 			enc.err = InvalidMarshalError(fmt.Sprintf(invalidMarshalErrorMsg, vt))
@@ -163,6 +169,8 @@ func (enc *Encoder) AddInterfaceKeyOmitEmpty(key string, v interface{}) {
 		enc.AddFloatKeyOmitEmpty(key, vt)
 	case float32:
 		enc.AddFloat32KeyOmitEmpty(key, vt)
+	case Decimal:
+		enc.AddDecimalKey(key, vt)
 	default:
 		if vt != nil {
 			enc.err = InvalidMarshalError(fmt.Sprintf(invalidMarshalErrorMsg, vt))
@@ -0,0 +1,211 @@
+package gojay
+
+import "strings"
+
+// FieldMask selects the fields of an object to encode, and of the objects and arrays nested in it.
+//
+// A field mask includes the fields it selects, or excludes them once Exclude is called.
+// A nil *FieldMask selects all fields.
+type FieldMask struct {
+	// fields selects the fields of the object, a nil mask selects the whole value of the field
+	fields  map[string]*FieldMask
+	exclude bool
+}
+
+// ParseFieldMask parses a selection of fields such as the one of a sparse fieldset query parameter,
+// i.e "id,name,address(city,zip),items.price".
+// The fields of nested objects are selected either between parentheses or with a dotted path.
+//
+// An empty selection returns a nil mask, selecting all fields.
+func ParseFieldMask(fields string) (*FieldMask, error) {
+	if strings.TrimSpace(fields) == "" {
+		return nil, nil
+	}
+	var p = fieldMaskParser{s: fields}
+	var m = newFieldMask(false)
+	if err := p.parseList(m, false); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// NewFieldMask returns the field mask selecting paths, as in a protobuf FieldMask,
+// i.e NewFieldMask("id", "address.city").
+//
+// Without path, it returns a nil mask, selecting all fields.
+func NewFieldMask(paths ...string) *FieldMask {
+	if len(paths) == 0 {
+		return nil
+	}
+	var m = newFieldMask(false)
+	for _, path := range paths {
+		m.add(strings.Split(path, "."), nil)
+	}
+	return m
+}
+
+func newFieldMask(exclude bool) *FieldMask {
+	return &FieldMask{fields: map[string]*FieldMask{}, exclude: exclude}
+}
+
+// Exclude returns a copy of the mask excluding the fields it selects instead of including them,
+// i.e the mask parsed from "password,card(number)" encodes all fields but password and the number of card.
+func (m *FieldMask) Exclude() *FieldMask {
+	if m == nil {
+		return nil
+	}
+	var result = newFieldMask(true)
+	for name, child := range m.fields {
+		result.fields[name] = child.Exclude()
+	}
+	return result
+}
+
+// add selects path, sub selects the fields of the value at path or is nil to select the whole value.
+func (m *FieldMask) add(path []string, sub *FieldMask) {
+	for i, name := range path {
+		child, ok := m.fields[name]
+		if ok && child == nil {
+			// the whole value is already selected
+			return
+		}
+		if i == len(path)-1 {
+			if !ok || sub == nil {
+				m.fields[name] = sub
+				return
+			}
+			for subName, subChild := range sub.fields {
+				child.add([]string{subName}, subChild)
+			}
+			return
+		}
+		if !ok {
+			child = newFieldMask(m.exclude)
+			m.fields[name] = child
+		}
+		m = child
+	}
+}
+
+// has returns true if the field k is encoded.
+func (m *FieldMask) has(k string) bool {
+	if m == nil {
+		return false
+	}
+	child, ok := m.fields[k]
+	if m.exclude {
+		// only fields excluded as a whole are not encoded
+		return !ok || child != nil
+	}
+	return ok
+}
+
+// child returns the mask of the value of the field k, nil if all its fields are encoded.
+func (m *FieldMask) child(k string) *FieldMask {
+	if m == nil {
+		return nil
+	}
+	return m.fields[k]
+}
+
+type fieldMaskParser struct {
+	s string
+	i int
+}
+
+// parseList parses comma separated fields up to the end of the selection, or the closing parenthesis if nested.
+func (p *fieldMaskParser) parseList(m *FieldMask, nested bool) error {
+	for {
+		path, err := p.parsePath()
+		if err != nil {
+			return err
+		}
+		var sub *FieldMask
+		if p.i < len(p.s) && p.s[p.i] == '(' {
+			p.i++
+			sub = newFieldMask(m.exclude)
+			if err = p.parseList(sub, true); err != nil {
+				return err
+			}
+			for p.i < len(p.s) && p.s[p.i] == ' ' {
+				p.i++
+			}
+		}
+		m.add(path, sub)
+		if p.i == len(p.s) {
+			if nested {
+				return InvalidFieldMaskError("Invalid field mask, missing closing parenthesis")
+			}
+			return nil
+		}
+		switch p.s[p.i] {
+		case ',':
+			p.i++
+		case ')':
+			if !nested {
+				return InvalidFieldMaskError("Invalid field mask, unexpected closing parenthesis")
+			}
+			p.i++
+			return nil
+		default:
+			return InvalidFieldMaskError("Invalid field mask, unexpected char '" + p.s[p.i:p.i+1] + "'")
+		}
+	}
+}
+
+// parsePath parses a dotted path of field names.
+func (p *fieldMaskParser) parsePath() ([]string, error) {
+	start := p.i
+	for p.i < len(p.s) && !strings.ContainsRune(",()", rune(p.s[p.i])) {
+		p.i++
+	}
+	path := strings.Split(p.s[start:p.i], ".")
+	for i, name := range path {
+		if path[i] = strings.TrimSpace(name); path[i] == "" {
+			return nil, InvalidFieldMaskError("Invalid field mask, empty field name")
+		}
+	}
+	return path, nil
+}
+
+// EncodeObjectMask encodes an object to JSON, only the fields selected by mask are encoded,
+// in the object and in the objects and arrays nested in it.
+func (enc *Encoder) EncodeObjectMask(v MarshalerJSONObject, mask *FieldMask) error {
+	if enc.isPooled == 1 {
+		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
+	}
+	enc.hasKeys = mask != nil
+	enc.keys = nil
+	enc.mask = mask
+	_, err := enc.encodeObject(v)
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	_, err = enc.Write()
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	return nil
+}
+
+// EncodeArrayMask encodes an array to JSON, only the fields selected by mask are encoded in the objects of the array.
+func (enc *Encoder) EncodeArrayMask(v MarshalerJSONArray, mask *FieldMask) error {
+	if enc.isPooled == 1 {
+		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
+	}
+	enc.mask = mask
+	_, err := enc.encodeArray(v)
+	enc.mask = nil
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	_, err = enc.Write()
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,287 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testMaskAddress struct {
+	street string
+	city   string
+	zip    string
+}
+
+func (a *testMaskAddress) MarshalJSONObject(enc *Encoder) {
+	enc.StringKey("street", a.street)
+	enc.StringKey("city", a.city)
+	enc.StringKey("zip", a.zip)
+}
+
+func (a *testMaskAddress) IsNil() bool {
+	return a == nil
+}
+
+type testMaskItem struct {
+	name  string
+	price float64
+}
+
+func (i *testMaskItem) MarshalJSONObject(enc *Encoder) {
+	enc.StringKey("name", i.name)
+	enc.FloatKey("price", i.price)
+}
+
+func (i *testMaskItem) IsNil() bool {
+	return i == nil
+}
+
+type testMaskItems []*testMaskItem
+
+func (items testMaskItems) MarshalJSONArray(enc *Encoder) {
+	for _, item := range items {
+		enc.Object(item)
+	}
+}
+
+func (items testMaskItems) IsNil() bool {
+	return len(items) == 0
+}
+
+type testMaskOrder struct {
+	id       int
+	name     string
+	password string
+	address  *testMaskAddress
+	items    testMaskItems
+}
+
+func (o *testMaskOrder) MarshalJSONObject(enc *Encoder) {
+	enc.IntKey("id", o.id)
+	enc.StringKey("name", o.name)
+	enc.StringKey("password", o.password)
+	enc.ObjectKey("address", o.address)
+	enc.ArrayKey("items", o.items)
+}
+
+func (o *testMaskOrder) IsNil() bool {
+	return o == nil
+}
+
+func newTestMaskOrder() *testMaskOrder {
+	return &testMaskOrder{
+		id:       1,
+		name:     "order",
+		password: "secret",
+		address:  &testMaskAddress{street: "main street", city: "Paris", zip: "75001"},
+		items: testMaskItems{
+			{name: "pen", price: 1.5},
+			{name: "book", price: 12},
+		},
+	}
+}
+
+func TestParseFieldMask(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fields   string
+		expected string
+	}{
+		{
+			name:     "flat",
+			fields:   "id,name",
+			expected: `{"id":1,"name":"order"}`,
+		},
+		{
+			name:     "parentheses",
+			fields:   "id,address(city,zip)",
+			expected: `{"id":1,"address":{"city":"Paris","zip":"75001"}}`,
+		},
+		{
+			name:     "dotted-paths",
+			fields:   "address.city,address.zip",
+			expected: `{"address":{"city":"Paris","zip":"75001"}}`,
+		},
+		{
+			name:     "array-elements",
+			fields:   "id,items.price",
+			expected: `{"id":1,"items":[{"price":1.5},{"price":12}]}`,
+		},
+		{
+			name:     "whole-value",
+			fields:   "address,items(price),address.city",
+			expected: `{"address":{"street":"main street","city":"Paris","zip":"75001"},"items":[{"price":1.5},{"price":12}]}`,
+		},
+		{
+			name:     "merged-subtrees",
+			fields:   "address(city),address.zip",
+			expected: `{"address":{"city":"Paris","zip":"75001"}}`,
+		},
+		{
+			name:     "spaces",
+			fields:   " id , address( city ) ",
+			expected: `{"id":1,"address":{"city":"Paris"}}`,
+		},
+		{
+			name:     "empty",
+			fields:   "",
+			expected: `{"id":1,"name":"order","password":"secret","address":{"street":"main street","city":"Paris","zip":"75001"},"items":[{"name":"pen","price":1.5},{"name":"book","price":12}]}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mask, err := ParseFieldMask(testCase.fields)
+			assert.Nil(t, err, "err should be nil")
+			var b strings.Builder
+			enc := NewEncoder(&b)
+			err = enc.EncodeObjectMask(newTestMaskOrder(), mask)
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, b.String())
+		})
+	}
+}
+
+func TestParseFieldMaskErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		fields string
+	}{
+		{name: "empty-name", fields: "id,,name"},
+		{name: "empty-path-segment", fields: "address..city"},
+		{name: "missing-closing-parenthesis", fields: "address(city"},
+		{name: "unexpected-closing-parenthesis", fields: "id)"},
+		{name: "unexpected-char", fields: "address(city)zip"},
+		{name: "empty-parentheses", fields: "address()"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mask, err := ParseFieldMask(testCase.fields)
+			assert.Nil(t, mask, "mask should be nil")
+			assert.IsType(t, InvalidFieldMaskError(""), err, "err should be an InvalidFieldMaskError")
+		})
+	}
+}
+
+func TestNewFieldMask(t *testing.T) {
+	var b strings.Builder
+	enc := NewEncoder(&b)
+	err := enc.EncodeObjectMask(newTestMaskOrder(), NewFieldMask("name", "address.zip", "items.name"))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"name":"order","address":{"zip":"75001"},"items":[{"name":"pen"},{"name":"book"}]}`, b.String())
+	assert.Nil(t, NewFieldMask(), "mask should be nil")
+}
+
+func TestFieldMaskExclude(t *testing.T) {
+	mask, err := ParseFieldMask("password,address(street),items.name")
+	assert.Nil(t, err, "err should be nil")
+	var b strings.Builder
+	enc := NewEncoder(&b)
+	err = enc.EncodeObjectMask(newTestMaskOrder(), mask.Exclude())
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"id":1,"name":"order","address":{"city":"Paris","zip":"75001"},"items":[{"price":1.5},{"price":12}]}`, b.String())
+	// the mask itself is left unchanged
+	b.Reset()
+	err = enc.EncodeObjectMask(newTestMaskOrder(), mask)
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"password":"secret","address":{"street":"main street"},"items":[{"name":"pen"},{"name":"book"}]}`, b.String())
+}
+
+func TestEncodeObjectMaskResets(t *testing.T) {
+	var b strings.Builder
+	enc := NewEncoder(&b)
+	err := enc.EncodeObjectMask(newTestMaskOrder(), NewFieldMask("id"))
+	assert.Nil(t, err, "err should be nil")
+	err = enc.EncodeObject(newTestMaskOrder())
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(
+		t,
+		`{"id":1}{"id":1,"name":"order","password":"secret","address":{"street":"main street","city":"Paris","zip":"75001"},"items":[{"name":"pen","price":1.5},{"name":"book","price":12}]}`,
+		b.String(),
+	)
+}
+
+func TestEncodeObjectMaskWithKeys(t *testing.T) {
+	var b strings.Builder
+	enc := NewEncoder(&b)
+	err := enc.EncodeObjectMask(EncodeObjectFunc(func(enc *Encoder) {
+		enc.IntKey("id", 1)
+		enc.ObjectKeyWithKeys("address", &testMaskAddress{city: "Paris", zip: "75001"}, []string{"zip"})
+	}), NewFieldMask("id", "address.city"))
+	assert.Nil(t, err, "err should be nil")
+	// keys given explicitly take precedence over the mask
+	assert.Equal(t, `{"id":1,"address":{"zip":"75001"}}`, b.String())
+}
+
+func TestEncodeArrayMask(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		var b strings.Builder
+		enc := NewEncoder(&b)
+		err := enc.EncodeArrayMask(newTestMaskOrder().items, NewFieldMask("price"))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, `[{"price":1.5},{"price":12}]`, b.String())
+	})
+	t.Run("write-error", func(t *testing.T) {
+		w := TestWriterError("")
+		enc := NewEncoder(w)
+		err := enc.EncodeArrayMask(newTestMaskOrder().items, NewFieldMask("price"))
+		assert.NotNil(t, err, "Error should not be nil")
+		assert.Equal(t, "Test Error", err.Error(), "err.Error() should be 'Test Error'")
+	})
+	t.Run("encode-error", func(t *testing.T) {
+		var b strings.Builder
+		enc := NewEncoder(&b)
+		err := enc.EncodeArrayMask(EncodeArrayFunc(func(enc *Encoder) {
+			enc.AddInterface(struct{}{})
+		}), NewFieldMask("price"))
+		assert.IsType(t, InvalidMarshalError(""), err, "err should be of type InvalidMarshalError")
+		assert.Empty(t, b.String(), "nothing should be written")
+	})
+	t.Run("flush-error", func(t *testing.T) {
+		w := &testFailingWriter{}
+		enc := NewEncoder(w)
+		enc.SetHighWaterMark(8)
+		err := enc.EncodeArrayMask(newTestMaskOrder().items, NewFieldMask("price"))
+		assert.NotNil(t, err, "err should not be nil")
+		assert.Equal(t, 1, w.writes, "encoder should stop writing after the first error")
+	})
+	t.Run("pool-error", func(t *testing.T) {
+		enc := BorrowEncoder(nil)
+		enc.isPooled = 1
+		defer func() {
+			err := recover()
+			assert.NotNil(t, err, "err shouldnt be nil")
+			assert.IsType(t, InvalidUsagePooledEncoderError(""), err, "err should be of type InvalidUsagePooledEncoderError")
+		}()
+		_ = enc.EncodeArrayMask(testMaskItems{}, nil)
+		assert.True(t, false, "should not be called as it should have panicked")
+	})
+}
+
+func TestEncodeObjectMaskErrors(t *testing.T) {
+	t.Run("write-error", func(t *testing.T) {
+		w := TestWriterError("")
+		enc := NewEncoder(w)
+		err := enc.EncodeObjectMask(newTestMaskOrder(), NewFieldMask("id"))
+		assert.NotNil(t, err, "Error should not be nil")
+		assert.Equal(t, "Test Error", err.Error(), "err.Error() should be 'Test Error'")
+	})
+	t.Run("pool-error", func(t *testing.T) {
+		enc := BorrowEncoder(nil)
+		enc.isPooled = 1
+		defer func() {
+			err := recover()
+			assert.NotNil(t, err, "err shouldnt be nil")
+			assert.IsType(t, InvalidUsagePooledEncoderError(""), err, "err should be of type InvalidUsagePooledEncoderError")
+		}()
+		_ = enc.EncodeObjectMask(newTestMaskOrder(), nil)
+		assert.True(t, false, "should not be called as it should have panicked")
+	})
+	t.Run("interface-key-error", func(t *testing.T) {
+		builder := &strings.Builder{}
+		enc := NewEncoder(builder)
+		err := enc.EncodeObjectMask(&testObjectWithUnknownType{struct{}{}}, NewFieldMask("unknownType"))
+		assert.NotNil(t, err, "Error should not be nil")
+		assert.Equal(t, "Invalid type struct {} provided to Marshal", err.Error(), "err.Error() should be 'Invalid type struct {} provided to Marshal'")
+	})
+}
@@ -17,7 +17,7 @@ func (enc *Encoder) EncodeFloat(n float64) error {
 
 // encodeFloat encodes a float64 to JSON
 func (enc *Encoder) encodeFloat(n float64) ([]byte, error) {
-	enc.buf = strconv.AppendFloat(enc.buf, n, 'f', -1, 64)
+	enc.writeFloat(n, 64)
 	return enc.buf, nil
 }
 
@@ -35,10 +35,23 @@ func (enc *Encoder) EncodeFloat32(n float32) error {
 }
 
 func (enc *Encoder) encodeFloat32(n float32) ([]byte, error) {
-	enc.buf = strconv.AppendFloat(enc.buf, float64(n), 'f', -1, 32)
+	enc.writeFloat(float64(n), 32)
 	return enc.buf, nil
 }
 
+// writeFloat writes v with the decimal places set by SetFloatPrecision, or in its shortest representation.
+func (enc *Encoder) writeFloat(v float64, bitSize int) {
+	enc.buf = strconv.AppendFloat(enc.buf, v, 'f', enc.floatPrec(), bitSize)
+}
+
+// floatPrec returns the precision of strconv.AppendFloat for the floats written by the encoder.
+func (enc *Encoder) floatPrec() int {
+	if enc.fixedFloats {
+		return enc.floatPrecision
+	}
+	return -1
+}
+
 // AddFloat adds a float64 to be encoded, must be used inside a slice or array encoding (does not encode a key)
 func (enc *Encoder) AddFloat(v float64) {
 	enc.Float64(v)
@@ -107,6 +120,24 @@ func (enc *Encoder) FloatKeyNullEmpty(key string, v float64) {
 	enc.Float64KeyNullEmpty(key, v)
 }
 
+// FloatPrecision adds a float64 to be encoded with digits decimal places, i.e. 12.50 for 2 digits,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) FloatPrecision(v float64, digits int) {
+	fixed, prec := enc.fixedFloats, enc.floatPrecision
+	enc.SetFloatPrecision(digits)
+	enc.Float64(v)
+	enc.fixedFloats, enc.floatPrecision = fixed, prec
+}
+
+// FloatKeyPrecision adds a float64 to be encoded with digits decimal places, i.e. 12.50 for 2 digits.
+// Must be used inside an object as it will encode a key
+func (enc *Encoder) FloatKeyPrecision(key string, v float64, digits int) {
+	fixed, prec := enc.fixedFloats, enc.floatPrecision
+	enc.SetFloatPrecision(digits)
+	enc.Float64Key(key, v)
+	enc.fixedFloats, enc.floatPrecision = fixed, prec
+}
+
 // AddFloat64 adds a float64 to be encoded, must be used inside a slice or array encoding (does not encode a key)
 func (enc *Encoder) AddFloat64(v float64) {
 	enc.Float(v)
@@ -120,12 +151,16 @@ func (enc *Encoder) AddFloat64OmitEmpty(v float64) {
 
 // Float64 adds a float64 to be encoded, must be used inside a slice or array encoding (does not encode a key)
 func (enc *Encoder) Float64(v float64) {
+	if enc.redact == redactAll {
+		enc.redactFloatValue(v, 64)
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.buf = strconv.AppendFloat(enc.buf, v, 'f', -1, 64)
+	enc.writeFloat(v, 64)
 }
 
 // Float64OmitEmpty adds a float64 to be encoded and skips it if its value is 0,
@@ -134,17 +169,25 @@ func (enc *Encoder) Float64OmitEmpty(v float64) {
 	if v == 0 {
 		return
 	}
+	if enc.redact == redactAll {
+		enc.redactFloatValue(v, 64)
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.buf = strconv.AppendFloat(enc.buf, v, 'f', -1, 64)
+	enc.writeFloat(v, 64)
 }
 
 // Float64NullEmpty adds a float64 to be encoded and skips it if its value is 0,
 // must be used inside a slice or array encoding (does not encode a key).
 func (enc *Encoder) Float64NullEmpty(v float64) {
+	if v != 0 && enc.redact == redactAll {
+		enc.redactFloatValue(v, 64)
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
@@ -154,7 +197,7 @@ func (enc *Encoder) Float64NullEmpty(v float64) {
 		enc.writeBytes(nullBytes)
 		return
 	}
-	enc.buf = strconv.AppendFloat(enc.buf, v, 'f', -1, 64)
+	enc.writeFloat(v, 64)
 }
 
 // AddFloat64Key adds a float64 to be encoded, must be used inside an object as it will encode a key
@@ -175,6 +218,9 @@ func (enc *Encoder) Float64Key(key string, value float64) {
 			return
 		}
 	}
+	if enc.redaction != nil && enc.redactFloat(key, value, 64) {
+		return
+	}
 	r := enc.getPreviousRune()
 	if r != '{' {
 		enc.writeByte(',')
@@ -183,7 +229,7 @@ func (enc *Encoder) Float64Key(key string, value float64) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKey)
-	enc.buf = strconv.AppendFloat(enc.buf, value, 'f', -1, 64)
+	enc.writeFloat(value, 64)
 }
 
 // Float64KeyOmitEmpty adds a float64 to be encoded and skips it if its value is 0.
@@ -197,6 +243,9 @@ func (enc *Encoder) Float64KeyOmitEmpty(key string, v float64) {
 	if v == 0 {
 		return
 	}
+	if enc.redaction != nil && enc.redactFloat(key, v, 64) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -205,7 +254,7 @@ func (enc *Encoder) Float64KeyOmitEmpty(key string, v float64) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKey)
-	enc.buf = strconv.AppendFloat(enc.buf, v, 'f', -1, 64)
+	enc.writeFloat(v, 64)
 }
 
 // Float64KeyNullEmpty adds a float64 to be encoded and skips it if its value is 0,
@@ -216,6 +265,9 @@ func (enc *Encoder) Float64KeyNullEmpty(key string, v float64) {
 			return
 		}
 	}
+	if v != 0 && enc.redaction != nil && enc.redactFloat(key, v, 64) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -228,7 +280,7 @@ func (enc *Encoder) Float64KeyNullEmpty(key string, v float64) {
 		enc.writeBytes(nullBytes)
 		return
 	}
-	enc.buf = strconv.AppendFloat(enc.buf, v, 'f', -1, 64)
+	enc.writeFloat(v, 64)
 }
 
 // AddFloat32 adds a float32 to be encoded, must be used inside a slice or array encoding (does not encode a key)
@@ -250,11 +302,15 @@ func (enc *Encoder) AddFloat32NullEmpty(v float32) {
 
 // Float32 adds a float32 to be encoded, must be used inside a slice or array encoding (does not encode a key)
 func (enc *Encoder) Float32(v float32) {
+	if enc.redact == redactAll {
+		enc.redactFloatValue(float64(v), 32)
+		return
+	}
 	r := enc.getPreviousRune()
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.buf = strconv.AppendFloat(enc.buf, float64(v), 'f', -1, 32)
+	enc.writeFloat(float64(v), 32)
 }
 
 // Float32OmitEmpty adds an int to be encoded and skips it if its value is 0,
@@ -263,17 +319,25 @@ func (enc *Encoder) Float32OmitEmpty(v float32) {
 	if v == 0 {
 		return
 	}
+	if enc.redact == redactAll {
+		enc.redactFloatValue(float64(v), 32)
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.buf = strconv.AppendFloat(enc.buf, float64(v), 'f', -1, 32)
+	enc.writeFloat(float64(v), 32)
 }
 
 // Float32NullEmpty adds an int to be encoded and skips it if its value is 0,
 // must be used inside a slice or array encoding (does not encode a key).
 func (enc *Encoder) Float32NullEmpty(v float32) {
+	if v != 0 && enc.redact == redactAll {
+		enc.redactFloatValue(float64(v), 32)
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
@@ -283,7 +347,7 @@ func (enc *Encoder) Float32NullEmpty(v float32) {
 		enc.writeBytes(nullBytes)
 		return
 	}
-	enc.buf = strconv.AppendFloat(enc.buf, float64(v), 'f', -1, 32)
+	enc.writeFloat(float64(v), 32)
 }
 
 // AddFloat32Key adds a float32 to be encoded, must be used inside an object as it will encode a key
@@ -310,6 +374,9 @@ func (enc *Encoder) Float32Key(key string, v float32) {
 			return
 		}
 	}
+	if enc.redaction != nil && enc.redactFloat(key, float64(v), 32) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -319,7 +386,7 @@ func (enc *Encoder) Float32Key(key string, v float32) {
 	enc.writeStringEscape(key)
 	enc.writeByte('"')
 	enc.writeByte(':')
-	enc.buf = strconv.AppendFloat(enc.buf, float64(v), 'f', -1, 32)
+	enc.writeFloat(float64(v), 32)
 }
 
 // Float32KeyOmitEmpty adds a float64 to be encoded and skips it if its value is 0.
@@ -333,6 +400,9 @@ func (enc *Encoder) Float32KeyOmitEmpty(key string, v float32) {
 	if v == 0 {
 		return
 	}
+	if enc.redaction != nil && enc.redactFloat(key, float64(v), 32) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -341,7 +411,7 @@ func (enc *Encoder) Float32KeyOmitEmpty(key string, v float32) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKey)
-	enc.buf = strconv.AppendFloat(enc.buf, float64(v), 'f', -1, 32)
+	enc.writeFloat(float64(v), 32)
 }
 
 // Float32KeyNullEmpty adds a float64 to be encoded and skips it if its value is 0.
@@ -352,6 +422,9 @@ func (enc *Encoder) Float32KeyNullEmpty(key string, v float32) {
 			return
 		}
 	}
+	if v != 0 && enc.redaction != nil && enc.redactFloat(key, float64(v), 32) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -364,5 +437,5 @@ func (enc *Encoder) Float32KeyNullEmpty(key string, v float32) {
 		enc.writeBytes(nullBytes)
 		return
 	}
-	enc.buf = strconv.AppendFloat(enc.buf, float64(v), 'f', -1, 32)
+	enc.writeFloat(float64(v), 32)
 }
@@ -237,3 +237,100 @@ func TestEncoderFloat32KeyNullEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestEncoderFloatKeyPrecision(t *testing.T) {
+	var testCases = []struct {
+		name         string
+		value        float64
+		digits       int
+		expectedJSON string
+	}{
+		{
+			name:         "two-digits",
+			value:        12.5,
+			digits:       2,
+			expectedJSON: `{"price":12.50,"qty":3}`,
+		},
+		{
+			name:         "rounded",
+			value:        0.125,
+			digits:       2,
+			expectedJSON: `{"price":0.12,"qty":3}`,
+		},
+		{
+			name:         "zero-digits",
+			value:        12.5,
+			digits:       0,
+			expectedJSON: `{"price":12,"qty":3}`,
+		},
+		{
+			name:         "negative-digits",
+			value:        12.5,
+			digits:       -1,
+			expectedJSON: `{"price":12.5,"qty":3}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var b strings.Builder
+			var enc = NewEncoder(&b)
+			err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+				enc.FloatKeyPrecision("price", testCase.value, testCase.digits)
+				// the precision only applies to the given float
+				enc.FloatKey("qty", 3)
+			}))
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expectedJSON, b.String())
+		})
+	}
+	t.Run("array", func(t *testing.T) {
+		var b strings.Builder
+		var enc = NewEncoder(&b)
+		err := enc.EncodeArray(EncodeArrayFunc(func(enc *Encoder) {
+			enc.FloatPrecision(1, 2)
+			enc.FloatPrecision(2.005, 1)
+			enc.Float(3)
+		}))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, `[1.00,2.0,3]`, b.String())
+	})
+}
+
+func TestEncoderSetFloatPrecision(t *testing.T) {
+	var b strings.Builder
+	var enc = NewEncoder(&b)
+	enc.SetFloatPrecision(2)
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.FloatKey("float", 1.5)
+		enc.Float32Key("float32", 0.1)
+		enc.FloatKeyOmitEmpty("omit", 0)
+		enc.FloatKeyNullEmpty("null", 0)
+		enc.FloatKeyPrecision("rate", 0.1234, 4)
+		enc.IntKey("int", 1)
+		enc.ArrayKey("floats", EncodeArrayFunc(func(enc *Encoder) {
+			enc.Float(1)
+			enc.Float32(2)
+		}))
+	}))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"float":1.50,"float32":0.10,"null":null,"rate":0.1234,"int":1,"floats":[1.00,2.00]}`, b.String())
+	// top level float
+	b.Reset()
+	err = enc.EncodeFloat(3)
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `3.00`, b.String())
+	// shortest representation
+	b.Reset()
+	enc.SetFloatPrecision(-1)
+	err = enc.EncodeFloat(3)
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `3`, b.String())
+	t.Run("borrowed-encoder-reset", func(t *testing.T) {
+		enc := BorrowEncoder(nil)
+		enc.SetFloatPrecision(2)
+		enc.Release()
+		enc = BorrowEncoder(nil)
+		defer enc.Release()
+		assert.False(t, enc.fixedFloats, "fixedFloats should be false")
+	})
+}
@@ -17,7 +17,7 @@ func (enc *Encoder) EncodeInt(n int) error {
 
 // encodeInt encodes an int to JSON
 func (enc *Encoder) encodeInt(n int) ([]byte, error) {
-	enc.buf = strconv.AppendInt(enc.buf, int64(n), 10)
+	enc.writeInt(int64(n))
 	return enc.buf, nil
 }
 
@@ -36,10 +36,21 @@ func (enc *Encoder) EncodeInt64(n int64) error {
 
 // encodeInt64 encodes an int to JSON
 func (enc *Encoder) encodeInt64(n int64) ([]byte, error) {
-	enc.buf = strconv.AppendInt(enc.buf, n, 10)
+	enc.writeInt(n)
 	return enc.buf, nil
 }
 
+// writeInt writes n, quoted in a string if the encoder quotes integers.
+func (enc *Encoder) writeInt(n int64) {
+	if enc.quotedIntegers {
+		enc.buf = append(enc.buf, '"')
+		enc.buf = strconv.AppendInt(enc.buf, n, 10)
+		enc.buf = append(enc.buf, '"')
+		return
+	}
+	enc.buf = strconv.AppendInt(enc.buf, n, 10)
+}
+
 // AddInt adds an int to be encoded, must be used inside a slice or array encoding (does not encode a key)
 func (enc *Encoder) AddInt(v int) {
 	enc.Int(v)
@@ -59,12 +70,16 @@ func (enc *Encoder) AddIntNullEmpty(v int) {
 
 // Int adds an int to be encoded, must be used inside a slice or array encoding (does not encode a key)
 func (enc *Encoder) Int(v int) {
+	if enc.redact == redactAll {
+		enc.redactIntValue(int64(v))
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.buf = strconv.AppendInt(enc.buf, int64(v), 10)
+	enc.writeInt(int64(v))
 }
 
 // IntOmitEmpty adds an int to be encoded and skips it if its value is 0,
@@ -73,17 +88,25 @@ func (enc *Encoder) IntOmitEmpty(v int) {
 	if v == 0 {
 		return
 	}
+	if enc.redact == redactAll {
+		enc.redactIntValue(int64(v))
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.buf = strconv.AppendInt(enc.buf, int64(v), 10)
+	enc.writeInt(int64(v))
 }
 
 // IntNullEmpty adds an int to be encoded and skips it if its value is 0,
 // must be used inside a slice or array encoding (does not encode a key).
 func (enc *Encoder) IntNullEmpty(v int) {
+	if v != 0 && enc.redact == redactAll {
+		enc.redactIntValue(int64(v))
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
@@ -93,7 +116,7 @@ func (enc *Encoder) IntNullEmpty(v int) {
 		enc.writeBytes(nullBytes)
 		return
 	}
-	enc.buf = strconv.AppendInt(enc.buf, int64(v), 10)
+	enc.writeInt(int64(v))
 }
 
 // AddIntKey adds an int to be encoded, must be used inside an object as it will encode a key
@@ -120,6 +143,9 @@ func (enc *Encoder) IntKey(key string, v int) {
 			return
 		}
 	}
+	if enc.redaction != nil && enc.redactInt(key, int64(v)) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -128,7 +154,7 @@ func (enc *Encoder) IntKey(key string, v int) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKey)
-	enc.buf = strconv.AppendInt(enc.buf, int64(v), 10)
+	enc.writeInt(int64(v))
 }
 
 // IntKeyOmitEmpty adds an int to be encoded and skips it if its value is 0.
@@ -142,6 +168,9 @@ func (enc *Encoder) IntKeyOmitEmpty(key string, v int) {
 	if v == 0 {
 		return
 	}
+	if enc.redaction != nil && enc.redactInt(key, int64(v)) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' && r != '[' {
@@ -150,7 +179,7 @@ func (enc *Encoder) IntKeyOmitEmpty(key string, v int) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKey)
-	enc.buf = strconv.AppendInt(enc.buf, int64(v), 10)
+	enc.writeInt(int64(v))
 }
 
 // IntKeyNullEmpty adds an int to be encoded and skips it if its value is 0.
@@ -161,6 +190,9 @@ func (enc *Encoder) IntKeyNullEmpty(key string, v int) {
 			return
 		}
 	}
+	if v != 0 && enc.redaction != nil && enc.redactInt(key, int64(v)) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' && r != '[' {
@@ -173,7 +205,7 @@ func (enc *Encoder) IntKeyNullEmpty(key string, v int) {
 		enc.writeBytes(nullBytes)
 		return
 	}
-	enc.buf = strconv.AppendInt(enc.buf, int64(v), 10)
+	enc.writeInt(int64(v))
 }
 
 // AddInt64 adds an int to be encoded, must be used inside a slice or array encoding (does not encode a key)
@@ -195,12 +227,16 @@ func (enc *Encoder) AddInt64NullEmpty(v int64) {
 
 // Int64 adds an int to be encoded, must be used inside a slice or array encoding (does not encode a key)
 func (enc *Encoder) Int64(v int64) {
+	if enc.redact == redactAll {
+		enc.redactIntValue(v)
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.buf = strconv.AppendInt(enc.buf, v, 10)
+	enc.writeInt(v)
 }
 
 // Int64OmitEmpty adds an int to be encoded and skips it if its value is 0,
@@ -209,17 +245,25 @@ func (enc *Encoder) Int64OmitEmpty(v int64) {
 	if v == 0 {
 		return
 	}
+	if enc.redact == redactAll {
+		enc.redactIntValue(v)
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.buf = strconv.AppendInt(enc.buf, v, 10)
+	enc.writeInt(v)
 }
 
 // Int64NullEmpty adds an int to be encoded and skips it if its value is 0,
 // must be used inside a slice or array encoding (does not encode a key).
 func (enc *Encoder) Int64NullEmpty(v int64) {
+	if v != 0 && enc.redact == redactAll {
+		enc.redactIntValue(v)
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
@@ -229,7 +273,7 @@ func (enc *Encoder) Int64NullEmpty(v int64) {
 		enc.writeBytes(nullBytes)
 		return
 	}
-	enc.buf = strconv.AppendInt(enc.buf, v, 10)
+	enc.writeInt(v)
 }
 
 // AddInt64Key adds an int64 to be encoded, must be used inside an object as it will encode a key
@@ -256,6 +300,9 @@ func (enc *Encoder) Int64Key(key string, v int64) {
 			return
 		}
 	}
+	if enc.redaction != nil && enc.redactInt(key, v) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -264,7 +311,7 @@ func (enc *Encoder) Int64Key(key string, v int64) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKey)
-	enc.buf = strconv.AppendInt(enc.buf, v, 10)
+	enc.writeInt(v)
 }
 
 // Int64KeyOmitEmpty adds an int64 to be encoded and skips it if its value is 0.
@@ -273,6 +320,9 @@ func (enc *Encoder) Int64KeyOmitEmpty(key string, v int64) {
 	if v == 0 {
 		return
 	}
+	if enc.redaction != nil && enc.redactInt(key, v) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -281,7 +331,7 @@ func (enc *Encoder) Int64KeyOmitEmpty(key string, v int64) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKey)
-	enc.buf = strconv.AppendInt(enc.buf, v, 10)
+	enc.writeInt(v)
 }
 
 // Int64KeyNullEmpty adds an int64 to be encoded and skips it if its value is 0.
@@ -292,6 +342,9 @@ func (enc *Encoder) Int64KeyNullEmpty(key string, v int64) {
 			return
 		}
 	}
+	if v != 0 && enc.redaction != nil && enc.redactInt(key, v) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -304,7 +357,48 @@ func (enc *Encoder) Int64KeyNullEmpty(key string, v int64) {
 		enc.writeBytes(nullBytes)
 		return
 	}
+	enc.writeInt(v)
+}
+
+// Int64String adds an int64 to be encoded quoted in a string, i.e. "123",
+// must be used inside a slice or array encoding (does not encode a key)
+func (enc *Encoder) Int64String(v int64) {
+	if enc.redact == redactAll {
+		enc.redactIntValue(v)
+		return
+	}
+	enc.grow(22)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeByte('"')
+	enc.buf = strconv.AppendInt(enc.buf, v, 10)
+	enc.writeByte('"')
+}
+
+// Int64KeyString adds an int64 to be encoded quoted in a string, i.e. "123",
+// must be used inside an object as it will encode a key
+func (enc *Encoder) Int64KeyString(key string, v int64) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	if enc.redaction != nil && enc.redactInt(key, v) {
+		return
+	}
+	enc.grow(25 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeTwoBytes(',', '"')
+	} else {
+		enc.writeByte('"')
+	}
+	enc.writeStringEscape(key)
+	enc.writeBytes(objKeyStr)
 	enc.buf = strconv.AppendInt(enc.buf, v, 10)
+	enc.writeByte('"')
 }
 
 // AddInt32 adds an int to be encoded, must be used inside a slice or array encoding (does not encode a key)
@@ -836,3 +836,103 @@ func TestEncoderInt8KeyNullEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestEncoderInt64String(t *testing.T) {
+	var testCases = []struct {
+		name         string
+		baseJSON     string
+		expectedJSON string
+	}{
+		{
+			name:         "basic 1st elem",
+			baseJSON:     "[",
+			expectedJSON: `["9007199254740993","-1"`,
+		},
+		{
+			name:         "basic 2nd elem",
+			baseJSON:     `["test"`,
+			expectedJSON: `["test","9007199254740993","-1"`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var b strings.Builder
+			var enc = NewEncoder(&b)
+			enc.writeString(testCase.baseJSON)
+			enc.Int64String(9007199254740993)
+			enc.Int64String(-1)
+			enc.Write()
+			assert.Equal(t, testCase.expectedJSON, b.String())
+		})
+	}
+}
+
+func TestEncoderInt64KeyString(t *testing.T) {
+	var testCases = []struct {
+		name         string
+		baseJSON     string
+		expectedJSON string
+	}{
+		{
+			name:         "basic 1st elem",
+			baseJSON:     "{",
+			expectedJSON: `{"foo":"9007199254740993","bar":"-1"`,
+		},
+		{
+			name:         "basic 2nd elem",
+			baseJSON:     `{"test":"test"`,
+			expectedJSON: `{"test":"test","foo":"9007199254740993","bar":"-1"`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var b strings.Builder
+			var enc = NewEncoder(&b)
+			enc.writeString(testCase.baseJSON)
+			enc.Int64KeyString("foo", 9007199254740993)
+			enc.Int64KeyString("bar", -1)
+			enc.Write()
+			assert.Equal(t, testCase.expectedJSON, b.String())
+		})
+	}
+	t.Run("with-keys", func(t *testing.T) {
+		var b strings.Builder
+		var enc = NewEncoder(&b)
+		err := enc.EncodeObjectKeys(EncodeObjectFunc(func(enc *Encoder) {
+			enc.Int64KeyString("foo", 1)
+			enc.Int64KeyString("bar", 2)
+		}), []string{"bar"})
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, `{"bar":"2"}`, b.String())
+	})
+}
+
+func TestEncoderQuotedIntegers(t *testing.T) {
+	var b strings.Builder
+	var enc = NewEncoder(&b)
+	enc.SetQuotedIntegers(true)
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.IntKey("int", -1)
+		enc.Int64Key("int64", 9007199254740993)
+		enc.Int8KeyNullEmpty("int8", 0)
+		enc.Int32KeyOmitEmpty("int32", 32)
+		enc.Uint64Key("uint64", 18446744073709551615)
+		enc.Uint16Key("uint16", 16)
+		enc.FloatKey("float", 1.5)
+		enc.ArrayKey("ints", EncodeArrayFunc(func(enc *Encoder) {
+			enc.Int(1)
+			enc.Int64(2)
+			enc.Uint64(3)
+		}))
+	}))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(
+		t,
+		`{"int":"-1","int64":"9007199254740993","int8":null,"int32":"32","uint64":"18446744073709551615","uint16":"16","float":1.5,"ints":["1","2","3"]}`,
+		b.String(),
+	)
+	b.Reset()
+	err = enc.EncodeInt64(42)
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `"42"`, b.String())
+}
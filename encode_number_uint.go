@@ -17,10 +17,21 @@ func (enc *Encoder) EncodeUint64(n uint64) error {
 
 // encodeUint64 encodes an int to JSON
 func (enc *Encoder) encodeUint64(n uint64) ([]byte, error) {
-	enc.buf = strconv.AppendUint(enc.buf, n, 10)
+	enc.writeUint(n)
 	return enc.buf, nil
 }
 
+// writeUint writes n, quoted in a string if the encoder quotes integers.
+func (enc *Encoder) writeUint(n uint64) {
+	if enc.quotedIntegers {
+		enc.buf = append(enc.buf, '"')
+		enc.buf = strconv.AppendUint(enc.buf, n, 10)
+		enc.buf = append(enc.buf, '"')
+		return
+	}
+	enc.buf = strconv.AppendUint(enc.buf, n, 10)
+}
+
 // AddUint64 adds an int to be encoded, must be used inside a slice or array encoding (does not encode a key)
 func (enc *Encoder) AddUint64(v uint64) {
 	enc.Uint64(v)
@@ -40,12 +51,16 @@ func (enc *Encoder) AddUint64NullEmpty(v uint64) {
 
 // Uint64 adds an int to be encoded, must be used inside a slice or array encoding (does not encode a key)
 func (enc *Encoder) Uint64(v uint64) {
+	if enc.redact == redactAll {
+		enc.redactUintValue(v)
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.buf = strconv.AppendUint(enc.buf, v, 10)
+	enc.writeUint(v)
 }
 
 // Uint64OmitEmpty adds an int to be encoded and skips it if its value is 0,
@@ -54,17 +69,25 @@ func (enc *Encoder) Uint64OmitEmpty(v uint64) {
 	if v == 0 {
 		return
 	}
+	if enc.redact == redactAll {
+		enc.redactUintValue(v)
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.buf = strconv.AppendUint(enc.buf, v, 10)
+	enc.writeUint(v)
 }
 
 // Uint64NullEmpty adds an int to be encoded and skips it if its value is 0,
 // must be used inside a slice or array encoding (does not encode a key).
 func (enc *Encoder) Uint64NullEmpty(v uint64) {
+	if v != 0 && enc.redact == redactAll {
+		enc.redactUintValue(v)
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
@@ -74,7 +97,7 @@ func (enc *Encoder) Uint64NullEmpty(v uint64) {
 		enc.writeBytes(nullBytes)
 		return
 	}
-	enc.buf = strconv.AppendUint(enc.buf, v, 10)
+	enc.writeUint(v)
 }
 
 // AddUint64Key adds an int to be encoded, must be used inside an object as it will encode a key
@@ -101,6 +124,9 @@ func (enc *Encoder) Uint64Key(key string, v uint64) {
 			return
 		}
 	}
+	if enc.redaction != nil && enc.redactUint(key, v) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -109,7 +135,7 @@ func (enc *Encoder) Uint64Key(key string, v uint64) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKey)
-	enc.buf = strconv.AppendUint(enc.buf, v, 10)
+	enc.writeUint(v)
 }
 
 // Uint64KeyOmitEmpty adds an int to be encoded and skips it if its value is 0.
@@ -123,6 +149,9 @@ func (enc *Encoder) Uint64KeyOmitEmpty(key string, v uint64) {
 	if v == 0 {
 		return
 	}
+	if enc.redaction != nil && enc.redactUint(key, v) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' && r != '[' {
@@ -131,7 +160,7 @@ func (enc *Encoder) Uint64KeyOmitEmpty(key string, v uint64) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKey)
-	enc.buf = strconv.AppendUint(enc.buf, v, 10)
+	enc.writeUint(v)
 }
 
 // Uint64KeyNullEmpty adds an int to be encoded and skips it if its value is 0.
@@ -142,6 +171,9 @@ func (enc *Encoder) Uint64KeyNullEmpty(key string, v uint64) {
 			return
 		}
 	}
+	if v != 0 && enc.redaction != nil && enc.redactUint(key, v) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' && r != '[' {
@@ -154,7 +186,48 @@ func (enc *Encoder) Uint64KeyNullEmpty(key string, v uint64) {
 		enc.writeBytes(nullBytes)
 		return
 	}
+	enc.writeUint(v)
+}
+
+// Uint64String adds a uint64 to be encoded quoted in a string, i.e. "123",
+// must be used inside a slice or array encoding (does not encode a key)
+func (enc *Encoder) Uint64String(v uint64) {
+	if enc.redact == redactAll {
+		enc.redactUintValue(v)
+		return
+	}
+	enc.grow(22)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeByte('"')
+	enc.buf = strconv.AppendUint(enc.buf, v, 10)
+	enc.writeByte('"')
+}
+
+// Uint64KeyString adds a uint64 to be encoded quoted in a string, i.e. "123",
+// must be used inside an object as it will encode a key
+func (enc *Encoder) Uint64KeyString(key string, v uint64) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	if enc.redaction != nil && enc.redactUint(key, v) {
+		return
+	}
+	enc.grow(25 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeTwoBytes(',', '"')
+	} else {
+		enc.writeByte('"')
+	}
+	enc.writeStringEscape(key)
+	enc.writeBytes(objKeyStr)
 	enc.buf = strconv.AppendUint(enc.buf, v, 10)
+	enc.writeByte('"')
 }
 
 // AddUint32 adds an int to be encoded, must be used inside a slice or array encoding (does not encode a key)
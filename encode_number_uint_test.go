@@ -771,3 +771,43 @@ func TestEncoderUint8KeyNullEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestEncoderUint64String(t *testing.T) {
+	var b strings.Builder
+	var enc = NewEncoder(&b)
+	enc.writeString("[")
+	enc.Uint64String(18446744073709551615)
+	enc.Uint64String(0)
+	enc.Write()
+	assert.Equal(t, `["18446744073709551615","0"`, b.String())
+}
+
+func TestEncoderUint64KeyString(t *testing.T) {
+	var testCases = []struct {
+		name         string
+		baseJSON     string
+		expectedJSON string
+	}{
+		{
+			name:         "basic 1st elem",
+			baseJSON:     "{",
+			expectedJSON: `{"foo":"18446744073709551615","bar":"0"`,
+		},
+		{
+			name:         "basic 2nd elem",
+			baseJSON:     `{"test":"test"`,
+			expectedJSON: `{"test":"test","foo":"18446744073709551615","bar":"0"`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var b strings.Builder
+			var enc = NewEncoder(&b)
+			enc.writeString(testCase.baseJSON)
+			enc.Uint64KeyString("foo", 18446744073709551615)
+			enc.Uint64KeyString("bar", 0)
+			enc.Write()
+			assert.Equal(t, testCase.expectedJSON, b.String())
+		})
+	}
+}
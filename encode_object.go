@@ -52,6 +52,7 @@ func (enc *Encoder) encodeObject(v MarshalerJSONObject) ([]byte, error) {
 	if enc.hasKeys {
 		enc.hasKeys = false
 		enc.keys = nil
+		enc.mask = nil
 	}
 	enc.writeByte('}')
 	return enc.buf, enc.err
@@ -119,7 +120,8 @@ func (enc *Encoder) Object(v MarshalerJSONObject) {
 
 	var origHasKeys = enc.hasKeys
 	var origKeys = enc.keys
-	enc.hasKeys = false
+	// the fields of array elements are selected by the mask of the array
+	enc.hasKeys = enc.mask != nil
 	enc.keys = nil
 
 	v.MarshalJSONObject(enc)
@@ -152,13 +154,16 @@ func (enc *Encoder) ObjectWithKeys(v MarshalerJSONObject, keys []string) {
 
 	var origKeys = enc.keys
 	var origHasKeys = enc.hasKeys
+	var origMask = enc.mask
 	enc.hasKeys = true
 	enc.keys = keys
+	enc.mask = nil
 
 	v.MarshalJSONObject(enc)
 
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
+	enc.mask = origMask
 
 	enc.writeByte('}')
 }
@@ -179,7 +184,8 @@ func (enc *Encoder) ObjectOmitEmpty(v MarshalerJSONObject) {
 
 	var origHasKeys = enc.hasKeys
 	var origKeys = enc.keys
-	enc.hasKeys = false
+	// the fields of array elements are selected by the mask of the array
+	enc.hasKeys = enc.mask != nil
 	enc.keys = nil
 
 	v.MarshalJSONObject(enc)
@@ -207,7 +213,8 @@ func (enc *Encoder) ObjectNullEmpty(v MarshalerJSONObject) {
 
 	var origHasKeys = enc.hasKeys
 	var origKeys = enc.keys
-	enc.hasKeys = false
+	// the fields of array elements are selected by the mask of the array
+	enc.hasKeys = enc.mask != nil
 	enc.keys = nil
 
 	v.MarshalJSONObject(enc)
@@ -249,13 +256,19 @@ func (enc *Encoder) ObjectKey(key string, v MarshalerJSONObject) {
 
 	var origHasKeys = enc.hasKeys
 	var origKeys = enc.keys
-	enc.hasKeys = false
+	var origMask = enc.mask
+	var origRedact = enc.redact
+	enc.mask = enc.mask.child(key)
+	enc.redact = enc.redaction.child(enc.redact, key)
+	enc.hasKeys = enc.mask != nil
 	enc.keys = nil
 
 	v.MarshalJSONObject(enc)
 
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
+	enc.mask = origMask
+	enc.redact = origRedact
 
 	enc.writeByte('}')
 }
@@ -290,11 +303,17 @@ func (enc *Encoder) ObjectKeyWithKeys(key string, value MarshalerJSONObject, key
 	enc.writeBytes(objKeyObj)
 	var origKeys = enc.keys
 	var origHasKeys = enc.hasKeys
+	var origMask = enc.mask
+	var origRedact = enc.redact
 	enc.hasKeys = true
 	enc.keys = keys
+	enc.mask = nil
+	enc.redact = enc.redaction.child(enc.redact, key)
 	value.MarshalJSONObject(enc)
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
+	enc.mask = origMask
+	enc.redact = origRedact
 	enc.writeByte('}')
 }
 
@@ -321,13 +340,19 @@ func (enc *Encoder) ObjectKeyOmitEmpty(key string, v MarshalerJSONObject) {
 
 	var origHasKeys = enc.hasKeys
 	var origKeys = enc.keys
-	enc.hasKeys = false
+	var origMask = enc.mask
+	var origRedact = enc.redact
+	enc.mask = enc.mask.child(key)
+	enc.redact = enc.redaction.child(enc.redact, key)
+	enc.hasKeys = enc.mask != nil
 	enc.keys = nil
 
 	v.MarshalJSONObject(enc)
 
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
+	enc.mask = origMask
+	enc.redact = origRedact
 
 	enc.writeByte('}')
 }
@@ -357,13 +382,19 @@ func (enc *Encoder) ObjectKeyNullEmpty(key string, v MarshalerJSONObject) {
 
 	var origHasKeys = enc.hasKeys
 	var origKeys = enc.keys
-	enc.hasKeys = false
+	var origMask = enc.mask
+	var origRedact = enc.redact
+	enc.mask = enc.mask.child(key)
+	enc.redact = enc.redaction.child(enc.redact, key)
+	enc.hasKeys = enc.mask != nil
 	enc.keys = nil
 
 	v.MarshalJSONObject(enc)
 
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
+	enc.mask = origMask
+	enc.redact = origRedact
 
 	enc.writeByte('}')
 }
@@ -389,7 +420,7 @@ func (f EncodeObjectFunc) IsNil() bool {
 
 func (enc *Encoder) keyExists(k string) bool {
 	if enc.keys == nil {
-		return false
+		return enc.mask.has(k)
 	}
 	for _, key := range enc.keys {
 		if key == k {
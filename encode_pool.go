@@ -38,8 +38,17 @@ func BorrowEncoder(w io.Writer) *Encoder {
 	enc.buf = enc.buf[:0]
 	enc.isPooled = 0
 	enc.err = nil
+	enc.writeErr = nil
 	enc.hasKeys = false
 	enc.keys = nil
+	enc.mask = nil
+	enc.redaction = nil
+	enc.redact = nil
+	enc.highWaterMark = 0
+	enc.quotedIntegers = false
+	enc.fixedFloats = false
+	enc.floatPrecision = 0
+	enc.lastFlushed = 0
 	return enc
 }
 
@@ -0,0 +1,243 @@
+package gojay
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"hash"
+	"strconv"
+	"strings"
+)
+
+// Redaction replaces the values of sensitive fields when encoding, i.e. to log objects without leaking secrets.
+//
+// The string and number values of the matching keys are encoded as a mask, "***" by default, or as their hash,
+// whichever method encodes them. All the string and number values of a matching object or array are replaced,
+// and embedded JSON is replaced as a whole.
+type Redaction struct {
+	// names are the key names matching at any depth
+	names   map[string]bool
+	root    *redactNode
+	mask    string
+	hash    bool
+	hashKey []byte
+}
+
+// redactNode matches the keys of an object, children["*"] matches any key.
+type redactNode struct {
+	children map[string]*redactNode
+	leaf     bool
+}
+
+// redactAll is the node of the values nested in a redacted value, all their keys are redacted.
+var redactAll = &redactNode{leaf: true}
+
+// NewRedaction returns a redaction of the keys matching paths.
+// A key name, i.e. "password", matches the key at any depth.
+// A dotted path, i.e. "card.number", matches from the encoded value, and "*" matches any key, i.e. "*.token".
+func NewRedaction(paths ...string) *Redaction {
+	var r = &Redaction{
+		names: map[string]bool{},
+		root:  &redactNode{},
+		mask:  "***",
+	}
+	for _, path := range paths {
+		if !strings.Contains(path, ".") {
+			r.names[path] = true
+			continue
+		}
+		var n = r.root
+		for _, name := range strings.Split(path, ".") {
+			n = n.add(name)
+		}
+		n.leaf = true
+	}
+	r.root.mergeWildcards()
+	return r
+}
+
+// SetMask sets the string replacing the redacted values.
+func (r *Redaction) SetMask(mask string) {
+	r.mask = mask
+	r.hash = false
+}
+
+// SetHash replaces the redacted values by the hex encoded HMAC-SHA256 of their text with key,
+// so that equal values can be correlated in logs.
+// With a nil key, the values are replaced by their SHA-256, which does not hide values easy to guess.
+func (r *Redaction) SetHash(key []byte) {
+	r.hash = true
+	r.hashKey = key
+}
+
+func (n *redactNode) add(name string) *redactNode {
+	if n.children == nil {
+		n.children = map[string]*redactNode{}
+	}
+	child, ok := n.children[name]
+	if !ok {
+		child = &redactNode{}
+		n.children[name] = child
+	}
+	return child
+}
+
+// mergeWildcards merges the wildcard child of each node in its other children,
+// so that a single child matches a key when encoding.
+func (n *redactNode) mergeWildcards() {
+	if wildcard, ok := n.children["*"]; ok {
+		for name, child := range n.children {
+			if name != "*" {
+				child.merge(wildcard)
+			}
+		}
+	}
+	for _, child := range n.children {
+		child.mergeWildcards()
+	}
+}
+
+func (n *redactNode) merge(o *redactNode) {
+	n.leaf = n.leaf || o.leaf
+	for name, child := range o.children {
+		n.add(name).merge(child)
+	}
+}
+
+// child returns the node of the value of key below n, redactAll if the value is redacted.
+func (r *Redaction) child(n *redactNode, key string) *redactNode {
+	if r == nil {
+		return nil
+	}
+	if n == redactAll || r.names[key] {
+		return redactAll
+	}
+	if n == nil {
+		return nil
+	}
+	child, ok := n.children[key]
+	if !ok {
+		child = n.children["*"]
+	}
+	if child != nil && child.leaf {
+		return redactAll
+	}
+	return child
+}
+
+// SetRedaction makes the encoder replace the values of the keys matched by r, nil disables the redaction.
+func (enc *Encoder) SetRedaction(r *Redaction) {
+	enc.redaction = r
+	enc.redact = nil
+	if r != nil {
+		enc.redact = r.root
+	}
+}
+
+func (enc *Encoder) redactString(key, v string) bool {
+	if enc.redaction.child(enc.redact, key) != redactAll {
+		return false
+	}
+	var value []byte
+	if enc.redaction.hash {
+		value = []byte(v)
+	}
+	enc.writeRedacted(key, value)
+	return true
+}
+
+func (enc *Encoder) redactInt(key string, v int64) bool {
+	if enc.redaction.child(enc.redact, key) != redactAll {
+		return false
+	}
+	var b [24]byte
+	enc.writeRedacted(key, strconv.AppendInt(b[:0], v, 10))
+	return true
+}
+
+func (enc *Encoder) redactUint(key string, v uint64) bool {
+	if enc.redaction.child(enc.redact, key) != redactAll {
+		return false
+	}
+	var b [24]byte
+	enc.writeRedacted(key, strconv.AppendUint(b[:0], v, 10))
+	return true
+}
+
+func (enc *Encoder) redactFloat(key string, v float64, bitSize int) bool {
+	if enc.redaction.child(enc.redact, key) != redactAll {
+		return false
+	}
+	var b [32]byte
+	enc.writeRedacted(key, strconv.AppendFloat(b[:0], v, 'f', enc.floatPrec(), bitSize))
+	return true
+}
+
+// redactStringValue encodes the replacement of v, an element of a redacted array.
+func (enc *Encoder) redactStringValue(v string) {
+	var value []byte
+	if enc.redaction.hash {
+		value = []byte(v)
+	}
+	enc.writeRedactedValue(value)
+}
+
+func (enc *Encoder) redactIntValue(v int64) {
+	var b [24]byte
+	enc.writeRedactedValue(strconv.AppendInt(b[:0], v, 10))
+}
+
+func (enc *Encoder) redactUintValue(v uint64) {
+	var b [24]byte
+	enc.writeRedactedValue(strconv.AppendUint(b[:0], v, 10))
+}
+
+func (enc *Encoder) redactFloatValue(v float64, bitSize int) {
+	var b [32]byte
+	enc.writeRedactedValue(strconv.AppendFloat(b[:0], v, 'f', enc.floatPrec(), bitSize))
+}
+
+// writeRedacted encodes key with the replacement of value.
+func (enc *Encoder) writeRedacted(key string, value []byte) {
+	enc.grow(len(key) + 2*sha256.Size + 5)
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeTwoBytes(',', '"')
+	} else {
+		enc.writeByte('"')
+	}
+	enc.writeStringEscape(key)
+	enc.writeBytes(objKeyStr)
+	enc.writeMask(value)
+}
+
+// writeRedactedValue encodes the replacement of value as an array element.
+func (enc *Encoder) writeRedactedValue(value []byte) {
+	enc.grow(2*sha256.Size + 3)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeTwoBytes(',', '"')
+	} else {
+		enc.writeByte('"')
+	}
+	enc.writeMask(value)
+}
+
+// writeMask writes the mask or the hash of value and the closing quote.
+func (enc *Encoder) writeMask(value []byte) {
+	if enc.redaction.hash {
+		var h hash.Hash
+		if enc.redaction.hashKey != nil {
+			h = hmac.New(sha256.New, enc.redaction.hashKey)
+		} else {
+			h = sha256.New()
+		}
+		h.Write(value)
+		var sum [sha256.Size]byte
+		for _, c := range h.Sum(sum[:0]) {
+			enc.buf = append(enc.buf, lowerHex[c>>4], lowerHex[c&0xF])
+		}
+	} else {
+		enc.writeStringEscape(enc.redaction.mask)
+	}
+	enc.writeByte('"')
+}
@@ -0,0 +1,388 @@
+package gojay
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	hexenc "encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testRedactCard struct {
+	number string
+	expiry string
+	cvc    int
+}
+
+func (c *testRedactCard) MarshalJSONObject(enc *Encoder) {
+	enc.StringKey("number", c.number)
+	enc.StringKey("expiry", c.expiry)
+	enc.IntKey("cvc", c.cvc)
+}
+
+func (c *testRedactCard) IsNil() bool {
+	return c == nil
+}
+
+type testRedactSession struct {
+	token   string
+	expires int64
+}
+
+func (s *testRedactSession) MarshalJSONObject(enc *Encoder) {
+	enc.StringKey("token", s.token)
+	enc.Int64Key("expires", s.expires)
+}
+
+func (s *testRedactSession) IsNil() bool {
+	return s == nil
+}
+
+type testRedactSessions []*testRedactSession
+
+func (sessions testRedactSessions) MarshalJSONArray(enc *Encoder) {
+	for _, s := range sessions {
+		enc.Object(s)
+	}
+}
+
+func (sessions testRedactSessions) IsNil() bool {
+	return len(sessions) == 0
+}
+
+type testRedactUser struct {
+	name     string
+	password string
+	token    string
+	card     *testRedactCard
+	session  *testRedactSession
+	sessions testRedactSessions
+}
+
+func (u *testRedactUser) MarshalJSONObject(enc *Encoder) {
+	enc.StringKey("name", u.name)
+	enc.StringKeyOmitEmpty("password", u.password)
+	enc.StringKey("token", u.token)
+	enc.ObjectKey("card", u.card)
+	enc.ObjectKeyOmitEmpty("session", u.session)
+	enc.ArrayKeyOmitEmpty("sessions", u.sessions)
+}
+
+func (u *testRedactUser) IsNil() bool {
+	return u == nil
+}
+
+func newTestRedactUser() *testRedactUser {
+	return &testRedactUser{
+		name:     "francois",
+		password: "hunter2",
+		token:    "root-token",
+		card:     &testRedactCard{number: "4242424242424242", expiry: "12/30", cvc: 123},
+		session:  &testRedactSession{token: "abc", expires: 42},
+		sessions: testRedactSessions{{token: "def", expires: 43}},
+	}
+}
+
+func TestEncoderRedaction(t *testing.T) {
+	testCases := []struct {
+		name     string
+		paths    []string
+		expected string
+	}{
+		{
+			name:     "none",
+			paths:    []string{},
+			expected: `{"name":"francois","password":"hunter2","token":"root-token","card":{"number":"4242424242424242","expiry":"12/30","cvc":123},"session":{"token":"abc","expires":42},"sessions":[{"token":"def","expires":43}]}`,
+		},
+		{
+			name:     "name-at-any-depth",
+			paths:    []string{"password", "token"},
+			expected: `{"name":"francois","password":"***","token":"***","card":{"number":"4242424242424242","expiry":"12/30","cvc":123},"session":{"token":"***","expires":42},"sessions":[{"token":"***","expires":43}]}`,
+		},
+		{
+			name:     "path",
+			paths:    []string{"card.number", "card.cvc"},
+			expected: `{"name":"francois","password":"hunter2","token":"root-token","card":{"number":"***","expiry":"12/30","cvc":"***"},"session":{"token":"abc","expires":42},"sessions":[{"token":"def","expires":43}]}`,
+		},
+		{
+			name:     "wildcard",
+			paths:    []string{"*.token", "session.expires"},
+			expected: `{"name":"francois","password":"hunter2","token":"root-token","card":{"number":"4242424242424242","expiry":"12/30","cvc":123},"session":{"token":"***","expires":"***"},"sessions":[{"token":"***","expires":43}]}`,
+		},
+		{
+			name:     "whole-object",
+			paths:    []string{"card", "sessions.*"},
+			expected: `{"name":"francois","password":"hunter2","token":"root-token","card":{"number":"***","expiry":"***","cvc":"***"},"session":{"token":"abc","expires":42},"sessions":[{"token":"***","expires":"***"}]}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var b strings.Builder
+			enc := NewEncoder(&b)
+			enc.SetRedaction(NewRedaction(testCase.paths...))
+			err := enc.EncodeObject(newTestRedactUser())
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, b.String())
+		})
+	}
+}
+
+func TestEncoderRedactionNumbers(t *testing.T) {
+	var b strings.Builder
+	enc := NewEncoder(&b)
+	enc.SetRedaction(NewRedaction("a", "b", "c", "d", "e", "f", "g"))
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.IntKeyNullEmpty("a", 1)
+		enc.Int64KeyOmitEmpty("b", 0)
+		enc.Int32Key("c", 3)
+		enc.Uint64Key("d", 4)
+		enc.Float32Key("e", 1.5)
+		enc.FloatKeyNullEmpty("f", 0)
+		enc.StringKeyNullEmpty("g", "")
+		enc.BoolKey("h", true)
+	}))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"a":"***","c":"***","d":"***","e":"***","f":null,"g":null,"h":true}`, b.String())
+}
+
+func TestEncoderRedactionOmitEmpty(t *testing.T) {
+	testCases := []struct {
+		name     string
+		encode   func(enc *Encoder)
+		expected string
+	}{
+		{
+			name: "keys-zero",
+			encode: func(enc *Encoder) {
+				enc.StringKeyOmitEmpty("s", "")
+				enc.IntKeyOmitEmpty("i", 0)
+				enc.AddIntKeyOmitEmpty("i", 0)
+				enc.Int64KeyOmitEmpty("i64", 0)
+				enc.Int8KeyOmitEmpty("i8", 0)
+				enc.Uint64KeyOmitEmpty("u64", 0)
+				enc.Uint16KeyOmitEmpty("u16", 0)
+				enc.Float64KeyOmitEmpty("f64", 0)
+				enc.Float32KeyOmitEmpty("f32", 0)
+			},
+			expected: `{}`,
+		},
+		{
+			name: "keys-non-zero",
+			encode: func(enc *Encoder) {
+				enc.StringKeyOmitEmpty("s", "secret")
+				enc.IntKeyOmitEmpty("i", 1)
+				enc.Int64KeyOmitEmpty("i64", 1)
+				enc.Uint64KeyOmitEmpty("u64", 1)
+				enc.Float64KeyOmitEmpty("f64", 1.5)
+				enc.Float32KeyOmitEmpty("f32", 1.5)
+			},
+			expected: `{"s":"***","i":"***","i64":"***","u64":"***","f64":"***","f32":"***"}`,
+		},
+		{
+			name: "null-empty",
+			encode: func(enc *Encoder) {
+				enc.StringKeyNullEmpty("s", "")
+				enc.IntKeyNullEmpty("i", 0)
+				enc.Int64KeyNullEmpty("i64", 1)
+				enc.Uint64KeyNullEmpty("u64", 0)
+				enc.Float64KeyNullEmpty("f64", 0)
+			},
+			expected: `{"s":null,"i":null,"i64":"***","u64":null,"f64":null}`,
+		},
+		{
+			name: "array",
+			encode: func(enc *Encoder) {
+				enc.ArrayKey("a", EncodeArrayFunc(func(enc *Encoder) {
+					enc.StringOmitEmpty("")
+					enc.AddIntOmitEmpty(0)
+					enc.IntOmitEmpty(0)
+					enc.Int64OmitEmpty(0)
+					enc.Uint64OmitEmpty(0)
+					enc.Float64OmitEmpty(0)
+					enc.Float32OmitEmpty(0)
+					enc.IntOmitEmpty(1)
+					enc.StringNullEmpty("")
+					enc.IntNullEmpty(0)
+					enc.StringOmitEmpty("secret")
+				}))
+			},
+			expected: `{"a":["***",null,null,"***"]}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var b strings.Builder
+			enc := NewEncoder(&b)
+			enc.SetRedaction(NewRedaction("s", "i", "i8", "i64", "u16", "u64", "f32", "f64", "a"))
+			err := enc.EncodeObject(EncodeObjectFunc(testCase.encode))
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, b.String())
+		})
+	}
+	t.Run("hash", func(t *testing.T) {
+		var b strings.Builder
+		enc := NewEncoder(&b)
+		r := NewRedaction("s", "i")
+		r.SetHash([]byte("key"))
+		enc.SetRedaction(r)
+		err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+			enc.StringKeyOmitEmpty("s", "")
+			enc.IntKeyOmitEmpty("i", 0)
+		}))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, `{}`, b.String(), "zero values should be omitted instead of hashed")
+	})
+}
+
+func TestEncoderRedactionArrays(t *testing.T) {
+	var date = time.Date(2030, 12, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		encode   func(enc *Encoder)
+		expected string
+	}{
+		{
+			name: "strings-and-numbers",
+			encode: func(enc *Encoder) {
+				enc.String("secret1")
+				enc.Int(42)
+				enc.Int64String(43)
+				enc.Uint8(44)
+				enc.Float32(1.5)
+				enc.FloatPrecision(2.5, 2)
+				enc.StringOmitEmpty("")
+				enc.IntNullEmpty(0)
+				enc.Bool(true)
+			},
+			expected: `{"tokens":["***","***","***","***","***","***",null,true],"name":"francois"}`,
+		},
+		{
+			name: "sql-null",
+			encode: func(enc *Encoder) {
+				enc.SQLNullString(&sql.NullString{String: "secret1", Valid: true})
+				enc.SQLNullInt64(&sql.NullInt64{Int64: 42, Valid: true})
+				enc.SQLNullFloat64(&sql.NullFloat64{Float64: 1.5, Valid: true})
+			},
+			expected: `{"tokens":["***","***","***"],"name":"francois"}`,
+		},
+		{
+			name: "time-and-embedded-json",
+			encode: func(enc *Encoder) {
+				enc.Time(&date, "2006-01-02")
+				enc.AddEmbeddedJSON(&EmbeddedJSON{'{', '}'})
+				enc.AddEmbeddedJSONOmitEmpty(&EmbeddedJSON{'1'})
+			},
+			expected: `{"tokens":["***","***","***"],"name":"francois"}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var b strings.Builder
+			enc := NewEncoder(&b)
+			enc.SetRedaction(NewRedaction("tokens"))
+			err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+				enc.ArrayKey("tokens", EncodeArrayFunc(testCase.encode))
+				enc.StringKey("name", "francois")
+			}))
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, b.String())
+		})
+	}
+}
+
+func TestEncoderRedactionKeys(t *testing.T) {
+	var b strings.Builder
+	var date = time.Date(2030, 12, 1, 0, 0, 0, 0, time.UTC)
+	enc := NewEncoder(&b)
+	enc.SetRedaction(NewRedaction("card", "expiry", "pin"))
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.AddEmbeddedJSONKey("card", &EmbeddedJSON{'{', '"', 'n', '"', ':', '"', '4', '"', '}'})
+		enc.TimeKey("expiry", &date, "2006-01-02")
+		enc.SQLNullInt64Key("pin", &sql.NullInt64{Int64: 1234, Valid: true})
+		enc.AddEmbeddedJSONKeyOmitEmpty("id", &EmbeddedJSON{'1'})
+	}))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"card":"***","expiry":"***","pin":"***","id":1}`, b.String())
+}
+
+func TestEncoderRedactionMask(t *testing.T) {
+	var b strings.Builder
+	enc := NewEncoder(&b)
+	r := NewRedaction("password")
+	r.SetMask(`<redacted "value">`)
+	enc.SetRedaction(r)
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.StringKey("password", "hunter2")
+	}))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"password":"<redacted \"value\">"}`, b.String())
+}
+
+func TestEncoderRedactionHash(t *testing.T) {
+	sum := sha256.Sum256([]byte("hunter2"))
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("123"))
+	testCases := []struct {
+		name     string
+		key      []byte
+		expected string
+	}{
+		{
+			name:     "sha256",
+			key:      nil,
+			expected: `{"password":"` + hexenc.EncodeToString(sum[:]) + `"}`,
+		},
+		{
+			name:     "hmac",
+			key:      []byte("secret"),
+			expected: `{"password":"` + hexenc.EncodeToString(mac.Sum(nil)) + `"}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var b strings.Builder
+			enc := NewEncoder(&b)
+			r := NewRedaction("password")
+			r.SetHash(testCase.key)
+			enc.SetRedaction(r)
+			err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+				if testCase.key == nil {
+					enc.StringKey("password", "hunter2")
+					return
+				}
+				enc.IntKey("password", 123)
+			}))
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, b.String())
+		})
+	}
+}
+
+func TestEncoderRedactionWithMask(t *testing.T) {
+	var b strings.Builder
+	enc := NewEncoder(&b)
+	enc.SetRedaction(NewRedaction("card.number"))
+	err := enc.EncodeObjectMask(newTestRedactUser(), NewFieldMask("name", "card.number", "card.expiry"))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"name":"francois","card":{"number":"***","expiry":"12/30"}}`, b.String())
+	// disabling the redaction
+	b.Reset()
+	enc.SetRedaction(nil)
+	err = enc.EncodeObjectMask(newTestRedactUser(), NewFieldMask("card.number"))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"card":{"number":"4242424242424242"}}`, b.String())
+}
+
+func TestBorrowEncoderRedaction(t *testing.T) {
+	enc := BorrowEncoder(nil)
+	enc.SetRedaction(NewRedaction("password"))
+	enc.Release()
+	enc = BorrowEncoder(nil)
+	defer enc.Release()
+	assert.Nil(t, enc.redaction, "redaction should be nil")
+	assert.Nil(t, enc.redact, "redact should be nil")
+}
@@ -0,0 +1,50 @@
+//go:build go1.23
+
+package gojay
+
+import "iter"
+
+// Seq is an iterator implementing MarshalerJSONArray, its values are encoded as the elements of a Slice.
+// It encodes an array from an iterator without materializing a slice:
+//	enc.ArrayKey("users", gojay.Seq[*User](users.All()))
+type Seq[T any] iter.Seq[T]
+
+// MarshalJSONArray implements MarshalerJSONArray.
+func (s Seq[T]) MarshalJSONArray(enc *Encoder) {
+	for v := range s {
+		addValue(enc, &v)
+		if enc.err != nil {
+			return
+		}
+	}
+}
+
+// IsNil implements MarshalerJSONArray.
+func (s Seq[T]) IsNil() bool {
+	return s == nil
+}
+
+// EncodeSeq encodes the values of seq to JSON as an array.
+// The iteration stops at the first value which can not be encoded, its error is returned.
+func EncodeSeq[T any](enc *Encoder, seq iter.Seq[T]) error {
+	if enc.isPooled == 1 {
+		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
+	}
+	_, err := enc.encodeArray(Seq[T](seq))
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	_, err = enc.Write()
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	return nil
+}
+
+// ArrayKeySeq adds the values of seq to be encoded as an array, must be used inside an object as it will encode a key.
+// Methods can not have type parameters, it takes the encoder as first argument.
+func ArrayKeySeq[T any](enc *Encoder, key string, seq iter.Seq[T]) {
+	enc.ArrayKey(key, Seq[T](seq))
+}
@@ -0,0 +1,75 @@
+//go:build go1.23
+
+package gojay
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeSeq(t *testing.T) {
+	t.Run("ints", func(t *testing.T) {
+		var b strings.Builder
+		enc := NewEncoder(&b)
+		err := EncodeSeq(enc, slices.Values([]int{1, 2, 3}))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, `[1,2,3]`, b.String())
+	})
+	t.Run("objects", func(t *testing.T) {
+		var b strings.Builder
+		enc := NewEncoder(&b)
+		err := EncodeSeq(enc, slices.Values([]*testMaskItem{{name: "pen", price: 1.5}, {name: "book", price: 12}}))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, `[{"name":"pen","price":1.5},{"name":"book","price":12}]`, b.String())
+	})
+	t.Run("empty", func(t *testing.T) {
+		var b strings.Builder
+		enc := NewEncoder(&b)
+		err := EncodeSeq(enc, slices.Values([]string(nil)))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, `[]`, b.String())
+	})
+	t.Run("pool-error", func(t *testing.T) {
+		enc := BorrowEncoder(nil)
+		enc.isPooled = 1
+		defer func() {
+			err := recover()
+			assert.NotNil(t, err, "err shouldnt be nil")
+			assert.IsType(t, InvalidUsagePooledEncoderError(""), err, "err should be of type InvalidUsagePooledEncoderError")
+		}()
+		_ = EncodeSeq(enc, slices.Values([]int{1}))
+		assert.True(t, false, "should not be called as it should have panicked")
+	})
+	t.Run("error-stops-the-iteration", func(t *testing.T) {
+		var b strings.Builder
+		enc := NewEncoder(&b)
+		var pulled int
+		err := EncodeSeq(enc, func(yield func(interface{}) bool) {
+			for _, v := range []interface{}{1, struct{}{}, 3} {
+				pulled++
+				if !yield(v) {
+					return
+				}
+			}
+		})
+		assert.NotNil(t, err, "err should not be nil")
+		assert.IsType(t, InvalidMarshalError(""), err, "err should be an InvalidMarshalError")
+		assert.Equal(t, 2, pulled)
+		assert.Equal(t, ``, b.String())
+	})
+}
+
+func TestArrayKeySeq(t *testing.T) {
+	var b strings.Builder
+	enc := NewEncoder(&b)
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		ArrayKeySeq(enc, "ids", slices.Values([]int64{1, 2}))
+		ArrayKeySeq[string](enc, "nil", nil)
+		enc.ArrayKeyOmitEmpty("omitted", Seq[string](nil))
+	}))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"ids":[1,2],"nil":[]}`, b.String())
+}
@@ -1,7 +1,6 @@
 package gojay
 
 import (
-	"strconv"
 	"sync"
 	"time"
 )
@@ -166,13 +165,13 @@ func (s *StreamEncoder) AddArray(v MarshalerJSONArray) {
 
 // AddInt adds an int to be encoded.
 func (s *StreamEncoder) AddInt(value int) {
-	s.buf = strconv.AppendInt(s.buf, int64(value), 10)
+	s.Encoder.writeInt(int64(value))
 	s.Encoder.writeByte(s.delimiter)
 }
 
 // AddFloat64 adds a float64 to be encoded.
 func (s *StreamEncoder) AddFloat64(value float64) {
-	s.buf = strconv.AppendFloat(s.buf, value, 'f', -1, 64)
+	s.Encoder.writeFloat(value, 64)
 	s.Encoder.writeByte(s.delimiter)
 }
 
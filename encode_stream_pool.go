@@ -22,6 +22,7 @@ func (s stream) BorrowEncoder(w io.Writer) *StreamEncoder {
 	streamEnc := streamEncPool.Get().(*StreamEncoder)
 	streamEnc.w = w
 	streamEnc.Encoder.err = nil
+	streamEnc.Encoder.writeErr = nil
 	streamEnc.done = make(chan struct{}, 1)
 	streamEnc.Encoder.buf = streamEnc.buf[:0]
 	streamEnc.nConsumer = 1
@@ -34,5 +35,6 @@ func (s stream) borrowEncoder(w io.Writer) *StreamEncoder {
 	streamEnc.isPooled = 0
 	streamEnc.w = w
 	streamEnc.Encoder.err = nil
+	streamEnc.Encoder.writeErr = nil
 	return streamEnc
 }
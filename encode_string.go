@@ -66,6 +66,10 @@ func (enc *Encoder) AddStringKeyNullEmpty(key, v string) {
 
 // String adds a string to be encoded, must be used inside a slice or array encoding (does not encode a key)
 func (enc *Encoder) String(v string) {
+	if enc.redact == redactAll {
+		enc.redactStringValue(v)
+		return
+	}
 	enc.grow(len(v) + 4)
 	r := enc.getPreviousRune()
 	if r != '[' {
@@ -83,6 +87,10 @@ func (enc *Encoder) StringOmitEmpty(v string) {
 	if v == "" {
 		return
 	}
+	if enc.redact == redactAll {
+		enc.redactStringValue(v)
+		return
+	}
 	r := enc.getPreviousRune()
 	if r != '[' {
 		enc.writeTwoBytes(',', '"')
@@ -96,6 +104,10 @@ func (enc *Encoder) StringOmitEmpty(v string) {
 // StringNullEmpty adds a string to be encoded or skips it if it is zero value.
 // Must be used inside a slice or array encoding (does not encode a key)
 func (enc *Encoder) StringNullEmpty(v string) {
+	if v != "" && enc.redact == redactAll {
+		enc.redactStringValue(v)
+		return
+	}
 	r := enc.getPreviousRune()
 	if v == "" {
 		if r != '[' {
@@ -122,6 +134,9 @@ func (enc *Encoder) StringKey(key, v string) {
 			return
 		}
 	}
+	if enc.redaction != nil && enc.redactString(key, v) {
+		return
+	}
 	enc.grow(len(key) + len(v) + 5)
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -146,6 +161,9 @@ func (enc *Encoder) StringKeyOmitEmpty(key, v string) {
 	if v == "" {
 		return
 	}
+	if enc.redaction != nil && enc.redactString(key, v) {
+		return
+	}
 	enc.grow(len(key) + len(v) + 5)
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -167,6 +185,9 @@ func (enc *Encoder) StringKeyNullEmpty(key, v string) {
 			return
 		}
 	}
+	if v != "" && enc.redaction != nil && enc.redactString(key, v) {
+		return
+	}
 	enc.grow(len(key) + len(v) + 5)
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -2,6 +2,8 @@ package gojay
 
 import (
 	"errors"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -43,3 +45,245 @@ func TestBuf(t *testing.T) {
 	enc.buf = b
 	assert.Equal(t, b, enc.Buf(), "enc.Buf() should equal to b")
 }
+
+// testFlushWriter records the size of each write
+type testFlushWriter struct {
+	strings.Builder
+	writes []int
+}
+
+func (w *testFlushWriter) Write(b []byte) (int, error) {
+	w.writes = append(w.writes, len(b))
+	return w.Builder.Write(b)
+}
+
+type testFlushRow struct {
+	id    int
+	name  string
+	tags  []string
+	inner *testFlushRow
+}
+
+func (r *testFlushRow) MarshalJSONObject(enc *Encoder) {
+	enc.IntKey("id", r.id)
+	enc.StringKey("name", r.name)
+	enc.ArrayKey("tags", EncodeArrayFunc(func(enc *Encoder) {
+		for _, tag := range r.tags {
+			enc.String(tag)
+		}
+	}))
+	enc.ObjectKeyOmitEmpty("inner", r.inner)
+}
+
+func (r *testFlushRow) IsNil() bool {
+	return r == nil
+}
+
+type testFlushRows []*testFlushRow
+
+func (rows testFlushRows) MarshalJSONArray(enc *Encoder) {
+	for _, row := range rows {
+		enc.Object(row)
+	}
+}
+
+func (rows testFlushRows) IsNil() bool {
+	return len(rows) == 0
+}
+
+func TestEncoderHighWaterMark(t *testing.T) {
+	var rows = testFlushRows{}
+	for i := 0; i < 200; i++ {
+		rows = append(rows, &testFlushRow{
+			id:    i,
+			name:  strings.Repeat("n", i%7),
+			tags:  []string{"a", "b"}[:i%3],
+			inner: &testFlushRow{id: -i, tags: []string{}},
+		})
+	}
+	expected, err := MarshalJSONArray(rows)
+	assert.Nil(t, err, "err should be nil")
+
+	for _, highWaterMark := range []int{1, 2, 7, 64, 1000} {
+		t.Run(strconv.Itoa(highWaterMark), func(t *testing.T) {
+			w := &testFlushWriter{}
+			enc := NewEncoder(w)
+			enc.SetHighWaterMark(highWaterMark)
+			err := enc.EncodeArray(rows)
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, string(expected), w.String(), "flushed output should equal the marshaled output")
+			assert.True(t, len(w.writes) > len(expected)/(highWaterMark+512), "buffer should be flushed while encoding")
+			for _, size := range w.writes[:len(w.writes)-1] {
+				assert.True(t, size < highWaterMark+512, "buffer should not grow much past the high water mark")
+			}
+		})
+	}
+	t.Run("object", func(t *testing.T) {
+		w := &testFlushWriter{}
+		enc := NewEncoder(w)
+		enc.SetHighWaterMark(1)
+		err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+			enc.ArrayKey("rows", rows[:3])
+			enc.IntKey("count", 3)
+		}))
+		assert.Nil(t, err, "err should be nil")
+		expected, err := MarshalJSONObject(EncodeObjectFunc(func(enc *Encoder) {
+			enc.ArrayKey("rows", rows[:3])
+			enc.IntKey("count", 3)
+		}))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, string(expected), w.String(), "flushed output should equal the marshaled output")
+	})
+	t.Run("write-error", func(t *testing.T) {
+		enc := NewEncoder(TestWriterError(""))
+		enc.SetHighWaterMark(16)
+		err := enc.EncodeArray(rows)
+		assert.NotNil(t, err, "err should not be nil")
+	})
+	t.Run("first-write-error", func(t *testing.T) {
+		w := &testFailingWriter{}
+		enc := NewEncoder(w)
+		enc.SetHighWaterMark(16)
+		err := enc.EncodeArray(rows)
+		assert.NotNil(t, err, "err should not be nil")
+		assert.Equal(t, 1, w.writes, "encoder should stop writing after the first error")
+		assert.True(t, len(enc.Buf()) < 64, "rest of the document should not be kept in the buffer")
+	})
+	t.Run("element-error", func(t *testing.T) {
+		w := &testFlushWriter{}
+		enc := NewEncoder(w)
+		enc.SetHighWaterMark(1)
+		err := enc.EncodeArray(EncodeArrayFunc(func(enc *Encoder) {
+			enc.AddInterface(struct{}{})
+			enc.AddArray(rows[:3])
+		}))
+		assert.Nil(t, err, "errors of the elements should be ignored by EncodeArray")
+		expected, _ := MarshalJSONArray(EncodeArrayFunc(func(enc *Encoder) {
+			enc.AddArray(rows[:3])
+		}))
+		assert.Equal(t, string(expected), w.String(), "elements after an element error should still be written")
+	})
+	t.Run("pooled", func(t *testing.T) {
+		enc := BorrowEncoder(nil)
+		enc.SetHighWaterMark(16)
+		enc.Release()
+		enc = BorrowEncoder(nil)
+		defer enc.Release()
+		assert.Equal(t, 0, enc.highWaterMark, "borrowed encoder should not flush")
+	})
+}
+
+// testFailingWriter fails its first write and accepts the next ones.
+type testFailingWriter struct {
+	writes int
+}
+
+func (w *testFailingWriter) Write(b []byte) (int, error) {
+	w.writes++
+	if w.writes == 1 {
+		return 0, errors.New("Test Error")
+	}
+	return len(b), nil
+}
+
+type testAppendObject struct {
+	id   int
+	name string
+}
+
+func (o *testAppendObject) MarshalJSONObject(enc *Encoder) {
+	enc.IntKey("id", o.id)
+	enc.StringKey("name", o.name)
+}
+
+func (o *testAppendObject) IsNil() bool {
+	return o == nil
+}
+
+func TestAppendObject(t *testing.T) {
+	var v = &testAppendObject{id: 1, name: "gojay"}
+	expected, err := MarshalJSONObject(v)
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, string(expected), string(AppendObject(nil, v)), "appended object should equal the marshaled object")
+	assert.Equal(t, `[`+string(expected), string(AppendObject([]byte(`[`), v)), "object should be appended to the buffer")
+	assert.Equal(t, `{}`, string(AppendObject(nil, (*testAppendObject)(nil))), "nil object should be appended as an empty object")
+}
+
+func TestAppendArray(t *testing.T) {
+	var v = EncodeArrayFunc(func(enc *Encoder) {
+		enc.Int(1)
+		enc.String("gojay")
+	})
+	assert.Equal(t, `[1,"gojay"]`, string(AppendArray(nil, v)), "array should be appended to an empty buffer")
+	assert.Equal(t, `1,[1,"gojay"]`, string(AppendArray([]byte(`1,`), v)), "array should be appended to the buffer")
+}
+
+func TestEncoderReset(t *testing.T) {
+	var v = &testAppendObject{id: 1, name: "gojay"}
+	t.Run("no-writer", func(t *testing.T) {
+		enc := NewEncoder(nil)
+		enc.Reset([]byte(`[`))
+		err := enc.EncodeObject(v)
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, `[{"id":1,"name":"gojay"}`, string(enc.Buf()), "object should be kept in the buffer")
+	})
+	t.Run("error", func(t *testing.T) {
+		enc := NewEncoder(nil)
+		err := enc.Encode(struct{}{})
+		assert.NotNil(t, err, "err should not be nil")
+		enc.Reset(nil)
+		assert.Nil(t, enc.err, "err should be reset")
+		assert.Nil(t, enc.EncodeInt(1), "err should be nil")
+		assert.Equal(t, `1`, string(enc.Buf()), "buffer should be reset")
+	})
+	t.Run("writer", func(t *testing.T) {
+		b := &strings.Builder{}
+		enc := NewEncoder(b)
+		enc.Reset(nil)
+		assert.Nil(t, enc.EncodeObject(v), "err should be nil")
+		assert.Equal(t, `{"id":1,"name":"gojay"}`, b.String(), "object should be written")
+		assert.Empty(t, enc.Buf(), "written bytes should not be kept")
+	})
+	t.Run("allocations", func(t *testing.T) {
+		enc := NewEncoder(nil)
+		var buf = make([]byte, 0, 1024)
+		allocs := testing.AllocsPerRun(100, func() {
+			enc.Reset(buf[:0])
+			enc.EncodeObject(v)
+			buf = enc.Buf()
+		})
+		assert.Equal(t, float64(0), allocs, "reusing the buffer should not allocate")
+	})
+}
+
+func TestEncoderSetError(t *testing.T) {
+	var first = errors.New("first")
+	v := EncodeObjectFunc(func(enc *Encoder) {
+		enc.IntKey("id", 1)
+		enc.SetError(first)
+		enc.SetError(errors.New("second"))
+	})
+	_, err := Marshal(v)
+	assert.Equal(t, first, err, "err should be the first error reported")
+	enc := NewEncoder(&strings.Builder{})
+	assert.Equal(t, first, enc.EncodeObject(v), "err should be the first error reported")
+}
+
+func BenchmarkMarshalJSONObject(b *testing.B) {
+	var v = &testAppendObject{id: 1, name: "gojay"}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := MarshalJSONObject(v); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAppendObject(b *testing.B) {
+	var v = &testAppendObject{id: 1, name: "gojay"}
+	var buf []byte
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendObject(buf[:0], v)
+	}
+}
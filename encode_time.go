@@ -37,6 +37,9 @@ func (enc *Encoder) TimeKey(key string, t *time.Time, format string) {
 			return
 		}
 	}
+	if enc.redaction != nil && enc.redactTime(key, t, format) {
+		return
+	}
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' {
@@ -50,6 +53,14 @@ func (enc *Encoder) TimeKey(key string, t *time.Time, format string) {
 	enc.writeByte('"')
 }
 
+func (enc *Encoder) redactTime(key string, t *time.Time, format string) bool {
+	if enc.redaction.child(enc.redact, key) != redactAll {
+		return false
+	}
+	enc.writeRedacted(key, t.AppendFormat(nil, format))
+	return true
+}
+
 // AddTime adds an *time.Time to be encoded with the given format, must be used inside a slice or array encoding (does not encode a key)
 func (enc *Encoder) AddTime(t *time.Time, format string) {
 	enc.Time(t, format)
@@ -57,6 +68,10 @@ func (enc *Encoder) AddTime(t *time.Time, format string) {
 
 // Time adds an *time.Time to be encoded with the given format, must be used inside a slice or array encoding (does not encode a key)
 func (enc *Encoder) Time(t *time.Time, format string) {
+	if enc.redact == redactAll {
+		enc.writeRedactedValue(t.AppendFormat(nil, format))
+		return
+	}
 	enc.grow(10)
 	r := enc.getPreviousRune()
 	if r != '[' {
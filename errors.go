@@ -17,7 +17,8 @@ func (err InvalidJSONError) Error() string {
 
 func (dec *Decoder) raiseInvalidJSONErr(pos int) error {
 	var c byte
-	if len(dec.data) > pos {
+	// bytes past the length are stale bytes of a pooled buffer
+	if dec.length > pos {
 		c = dec.data[pos]
 	}
 	dec.err = InvalidJSONError(
@@ -86,3 +87,22 @@ func (err InvalidUsagePooledEncoderError) Error() string {
 // ErrUnmarshalPtrExpected is the error returned when unmarshal expects a pointer value,
 // When using `dec.ObjectNull` or `dec.ArrayNull` for example.
 var ErrUnmarshalPtrExpected = errors.New("Cannot unmarshal to given value, a pointer is expected")
+
+// ErrPointerNotFound is the error returned when a JSON Pointer does not reference any value of the document.
+var ErrPointerNotFound = errors.New("JSON Pointer does not reference any value")
+
+// InvalidPointerError is a type representing an error returned when
+// a JSON Pointer is not valid as defined in RFC 6901.
+type InvalidPointerError string
+
+func (err InvalidPointerError) Error() string {
+	return string(err)
+}
+
+// InvalidFieldMaskError is a type representing an error returned when
+// a field mask can not be parsed.
+type InvalidFieldMaskError string
+
+func (err InvalidFieldMaskError) Error() string {
+	return string(err)
+}
@@ -0,0 +1,287 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	generateAnnotation  = "//gojay:generate"
+	generatedFileSuffix = "_gojay.go"
+	generatedCodeHeader = "// Code generated by Gojay. DO NOT EDIT."
+)
+
+// generateAnnotated generates the code of the types annotated with //gojay:generate
+// in every source package, one file per source file declaring annotated or dependent types.
+func (g *Generator) generateAnnotated() error {
+	var stale = []string{}
+	for _, source := range strings.Split(g.options.Source, ",") {
+		dirs, err := sourceDirs(source)
+		if err != nil {
+			return err
+		}
+		for _, dir := range dirs {
+			files, err := g.generatePackage(dir)
+			if err != nil {
+				return err
+			}
+			stale = append(stale, files...)
+		}
+	}
+	if len(stale) > 0 {
+		return fmt.Errorf("generated code is out of date: %v", strings.Join(stale, ", "))
+	}
+	return nil
+}
+
+// generatePackage generates the code of the package in dir and returns the files which are out of date in check mode
+func (g *Generator) generatePackage(dir string) ([]string, error) {
+	annotated, pkg, err := findAnnotatedTypes(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(annotated) == 0 {
+		// a package without annotation has no generated code
+		return g.removeGeneratedFiles(dir, nil)
+	}
+	g.init()
+	if err = g.readPackageCode(dir); err != nil {
+		return nil, err
+	}
+	if g.options.Pkg == "" {
+		g.Pkg = pkg
+	}
+	for _, file := range sortedFiles(annotated) {
+		for _, typeName := range annotated[file] {
+			if err = g.generateStructCode(typeName); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var sourceFiles = map[string]string{}
+	for typeName := range g.structTypes {
+		sourceFiles[g.typeFile(typeName)] = typeName
+	}
+	for key, file := range g.helperFiles {
+		sourceFiles[file] = key
+	}
+	var stale = []string{}
+	var generated = map[string]bool{}
+	for _, sourceFile := range sortedKeys(sourceFiles) {
+		code, err := g.generateCode(func(file string) bool { return file == sourceFile })
+		if err != nil {
+			return nil, err
+		}
+		filename := filepath.Join(dir, strings.TrimSuffix(sourceFile, ".go")+generatedFileSuffix)
+		generated[filename] = true
+		upToDate, err := g.writeFile(filename, code)
+		if err != nil {
+			return nil, err
+		}
+		if !upToDate {
+			stale = append(stale, filename)
+		}
+		if !g.options.Tests {
+			continue
+		}
+		testCode, err := g.generateTestCode(func(file string) bool { return file == sourceFile })
+		if err != nil {
+			return nil, err
+		}
+		if testCode == nil {
+			continue
+		}
+		filename = testFileName(filename)
+		generated[filename] = true
+		if upToDate, err = g.writeFile(filename, testCode); err != nil {
+			return nil, err
+		}
+		if !upToDate {
+			stale = append(stale, filename)
+		}
+	}
+	orphans, err := g.removeGeneratedFiles(dir, generated)
+	return append(stale, orphans...), err
+}
+
+// removeGeneratedFiles removes the generated files of dir which are not in keep, in check mode they are only reported
+func (g *Generator) removeGeneratedFiles(dir string, keep map[string]bool) ([]string, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*"+generatedFileSuffix))
+	if err != nil {
+		return nil, err
+	}
+	testFilenames, err := filepath.Glob(filepath.Join(dir, "*"+generatedTestFileSuffix))
+	if err != nil {
+		return nil, err
+	}
+	filenames = append(filenames, testFilenames...)
+	var orphans = []string{}
+	for _, filename := range filenames {
+		if keep[filename] {
+			continue
+		}
+		content, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.HasPrefix(content, []byte(generatedCodeHeader)) {
+			continue
+		}
+		if g.options.Check {
+			orphans = append(orphans, filename)
+			continue
+		}
+		if err = os.Remove(filename); err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(orphans)
+	return orphans, nil
+}
+
+// findAnnotatedTypes returns the types annotated with //gojay:generate for each file of the package in dir, and the package name
+func findAnnotatedTypes(dir string) (map[string][]string, string, error) {
+	fileSet := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fileSet, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && !strings.HasSuffix(info.Name(), generatedFileSuffix)
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, "", err
+	}
+	var result = map[string][]string{}
+	var pkgName string
+	for _, pkg := range pkgs {
+		for filename, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok || genDecl.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range genDecl.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					doc := typeSpec.Doc
+					if doc == nil && len(genDecl.Specs) == 1 {
+						doc = genDecl.Doc
+					}
+					if !hasGenerateAnnotation(doc) {
+						continue
+					}
+					filename = filepath.Base(filename)
+					result[filename] = append(result[filename], typeSpec.Name.Name)
+					pkgName = pkg.Name
+				}
+			}
+		}
+	}
+	return result, pkgName, nil
+}
+
+func hasGenerateAnnotation(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, comment := range doc.List {
+		if strings.TrimSpace(comment.Text) == generateAnnotation {
+			return true
+		}
+	}
+	return false
+}
+
+// sourceDirs returns the source dir, or all dirs below it if source ends with /...
+func sourceDirs(source string) ([]string, error) {
+	if !strings.HasSuffix(source, "...") {
+		if f, err := os.Stat(source); err == nil && !f.IsDir() {
+			return []string{filepath.Dir(source)}, nil
+		}
+		return []string{source}, nil
+	}
+	var root = filepath.Clean(strings.TrimSuffix(source, "..."))
+	var result = []string{}
+	err := filepath.Walk(root, func(filename string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		name := info.Name()
+		if filename != root && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+			return filepath.SkipDir
+		}
+		if goFiles, _ := filepath.Glob(filepath.Join(filename, "*.go")); len(goFiles) > 0 {
+			result = append(result, filename)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// removeUnusedImports removes from code the imports which are not referenced
+func removeUnusedImports(code []byte) ([]byte, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "", code, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	var used = map[string]bool{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+	var removed bool
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			continue
+		}
+		var specs = genDecl.Specs[:0]
+		for _, spec := range genDecl.Specs {
+			importSpec := spec.(*ast.ImportSpec)
+			importPath, _ := strconv.Unquote(importSpec.Path.Value)
+			name := path.Base(importPath)
+			if importSpec.Name != nil {
+				name = importSpec.Name.Name
+			}
+			if used[name] {
+				specs = append(specs, spec)
+				continue
+			}
+			removed = true
+		}
+		genDecl.Specs = specs
+	}
+	if !removed {
+		return code, nil
+	}
+	var buf = new(bytes.Buffer)
+	if err = format.Node(buf, fileSet, file); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+func sortedFiles(m map[string][]string) []string {
+	var result = make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
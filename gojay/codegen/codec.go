@@ -0,0 +1,69 @@
+package codegen
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// stringCodec returns the calls parsing a number or bool from text and formatting value as a string, for the string tag option
+func stringCodec(typeName, value string) (string, string, bool) {
+	switch typeName {
+	case "int", "int8", "int16", "int32", "int64":
+		return fmt.Sprintf("strconv.ParseInt(text, 10, %v)", bitSize(typeName)), fmt.Sprintf("strconv.FormatInt(int64(%v), 10)", value), true
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		return fmt.Sprintf("strconv.ParseUint(text, 10, %v)", bitSize(typeName)), fmt.Sprintf("strconv.FormatUint(uint64(%v), 10)", value), true
+	case "float32", "float64":
+		return fmt.Sprintf("strconv.ParseFloat(text, %v)", bitSize(typeName)), fmt.Sprintf("strconv.FormatFloat(float64(%v), 'f', -1, %v)", value, bitSize(typeName)), true
+	case "bool":
+		return "strconv.ParseBool(text)", fmt.Sprintf("strconv.FormatBool(bool(%v))", value), true
+	}
+	return "", "", false
+}
+
+// bitSize returns the bit size of a number type, 0 stands for the platform size of int and uint
+func bitSize(typeName string) string {
+	var size = strings.TrimLeft(typeName, "uintfloat")
+	if size == "" {
+		return "0"
+	}
+	return size
+}
+
+// codecFuncs returns the encoding and decoding functions of a codec, i.e ids.EncodeID and ids.DecodeID for ids.ID
+func codecFuncs(codec string) (string, string) {
+	var qualifier, name = "", codec
+	if index := strings.LastIndex(codec, "."); index != -1 {
+		qualifier, name = codec[:index+1], codec[index+1:]
+	}
+	return qualifier + "Encode" + name, qualifier + "Decode" + name
+}
+
+// addCodecImport imports the package of a codec from another package, as imported by the source files
+func (s *Struct) addCodecImport(codec string) error {
+	index := strings.LastIndex(codec, ".")
+	if index == -1 {
+		return nil
+	}
+	var name = codec[:index]
+	var files = []string{s.file}
+	for file := range s.fileInfo.FilesInfo() {
+		files = append(files, file)
+	}
+	for _, file := range files {
+		fileInfo := s.fileInfo.FileInfo(file)
+		if fileInfo == nil {
+			continue
+		}
+		if importPath, ok := fileInfo.Imports[name]; ok {
+			importPath = strings.Trim(importPath, `"`)
+			if path.Base(importPath) != name {
+				s.imports[name+` "`+importPath+`"`] = true
+			} else {
+				s.addImport(importPath)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("Unknown package %s of codec %s", name, codec)
+}
@@ -0,0 +1,237 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/viant/toolbox"
+)
+
+// compositeType represents the helper type of a slice or array whose elements need their own helper type or are indexed,
+// i.e type Float64sSlice [][]float64 or type Float64Array2 [2]float64
+type compositeType struct {
+	HelperType     string
+	RawType        string
+	IsArray        bool   //elements are stored by index and the elements beyond the array length are skipped
+	IsPointer      bool   //elements are pointers, decoded from and encoded to null when nil
+	IsRawJSON      bool   //elements are []byte holding raw JSON as []byte fields do, encoded to null when empty
+	DecodedType    string //type of the decoded value
+	ValueInit      string //initialises value, empty to use the decoded type zero value
+	DecodingCall   string
+	ValueStore     string //converts decoded value to the element type
+	EncodingMethod string
+	ValueEncode    string //converts element to what the encoding method takes
+}
+
+// isCompositeFieldType returns true if a field type needs helper types for several levels or is indexed,
+// i.e [3]int, [][]float64, []map[string]int or *[]string
+func isCompositeFieldType(typeName string, isPointer bool) bool {
+	if isArrayType(typeName) {
+		return true
+	}
+	if !strings.HasPrefix(typeName, "[]") {
+		return false
+	}
+	return isPointer || isCollectionType(strings.TrimPrefix(typeName[2:], "*"))
+}
+
+// isArrayType returns true for a fixed size array type, i.e [3]int
+func isArrayType(typeName string) bool {
+	return len(typeName) > 1 && typeName[0] == '[' && typeName[1] != ']'
+}
+
+// isCollectionType returns true for a slice, array or map type
+func isCollectionType(typeName string) bool {
+	return strings.HasPrefix(typeName, "[") || strings.HasPrefix(typeName, "map[")
+}
+
+// splitCollectionType returns the length and the element type of an array or slice type, i.e 3 and int for [3]int
+func splitCollectionType(typeName string) (string, string) {
+	index := strings.Index(typeName, "]")
+	return typeName[1:index], typeName[index+1:]
+}
+
+// splitMapType returns the key and the value type of a map type, i.e string and []int for map[string][]int
+func splitMapType(typeName string) (string, string) {
+	typeName = strings.TrimPrefix(typeName, "map[")
+	index := strings.Index(typeName, "]")
+	return typeName[:index], typeName[index+1:]
+}
+
+// elementType returns the innermost element type of a composite type, i.e Point for [][]*Point
+func elementType(typeName string) string {
+	for {
+		typeName = strings.TrimPrefix(typeName, "*")
+		switch {
+		case strings.HasPrefix(typeName, "map["):
+			_, typeName = splitMapType(typeName)
+		case strings.HasPrefix(typeName, "["):
+			_, typeName = splitCollectionType(typeName)
+		default:
+			return typeName
+		}
+	}
+}
+
+// compositeHelperTypeName returns the helper type name of a slice, array or map type, each level is part of the name so that names do not collide,
+// i.e Float64s for []float64, Float64sSlice for [][]float64, Float64Array2Slice for [][2]float64 and PtrIntsSlice for []*[]int
+func compositeHelperTypeName(typeName string) string {
+	switch {
+	case strings.HasPrefix(typeName, "map["):
+		return getMapHelperTypeName(splitMapType(typeName))
+	case strings.HasPrefix(typeName, "[]"):
+		element := typeName[2:]
+		if !isCollectionType(strings.TrimPrefix(element, "*")) {
+			return getSliceHelperTypeName(normalizeTypeName(element), strings.HasPrefix(element, "*"))
+		}
+		return elementHelperTypeName(element) + "Slice"
+	}
+	length, element := splitCollectionType(typeName)
+	return elementHelperTypeName(element) + "Array" + length
+}
+
+func elementHelperTypeName(typeName string) string {
+	if strings.HasPrefix(typeName, "*") {
+		if isCollectionType(typeName[1:]) {
+			return "Ptr" + compositeHelperTypeName(typeName[1:])
+		}
+		return elementHelperTypeName(typeName[1:]) + "Ptr"
+	}
+	if isCollectionType(typeName) {
+		return compositeHelperTypeName(typeName)
+	}
+	return strings.Replace(firstLetterToUppercase(typeName), ".", "", -1)
+}
+
+// generateCompositeType generates the helper types of a slice, array or map type and of its elements, and returns the helper type name
+func (s *Struct) generateCompositeType(fieldName, typeName string) (string, error) {
+	helperType := compositeHelperTypeName(typeName)
+	if strings.HasPrefix(typeName, "map[") {
+		keyType, valueType := splitMapType(typeName)
+		return helperType, s.generateMapType(&toolbox.FieldInfo{
+			Name:          fieldName,
+			TypeName:      typeName,
+			IsMap:         true,
+			KeyTypeName:   keyType,
+			ValueTypeName: valueType,
+		})
+	}
+	length, element := splitCollectionType(typeName)
+	if length == "" && !isCollectionType(strings.TrimPrefix(element, "*")) {
+		// slices of builtin, named and struct types share the helper types of slice fields
+		_, err := s.generateSliceType(fieldName, typeName)
+		return helperType, err
+	}
+	if _, ok := s.sliceTypes[typeName]; ok {
+		return helperType, nil
+	}
+	var result = &compositeType{
+		HelperType: helperType,
+		RawType:    typeName,
+		IsArray:    length != "",
+		IsPointer:  strings.HasPrefix(element, "*"),
+	}
+	if err := s.setCompositeElement(result, fieldName, strings.TrimPrefix(element, "*")); err != nil {
+		return "", err
+	}
+	code, err := expandBlockTemplate(compositeTypeCode, result)
+	if err != nil {
+		return "", err
+	}
+	s.sliceTypes[typeName] = code
+	s.helperFiles[typeName] = s.file
+	return helperType, nil
+}
+
+// setCompositeElement sets how the elements of a composite type are decoded and encoded
+func (s *Struct) setCompositeElement(result *compositeType, fieldName, element string) error {
+	var item = "item"
+	if result.IsPointer {
+		item = "*item"
+	}
+	result.DecodedType = element
+	result.ValueStore = "value"
+	result.ValueEncode = item
+	if element == "byte" || element == "[]byte" {
+		element = strings.Replace(element, "byte", "uint8", 1)
+	}
+	switch {
+	case element == "[]uint8":
+		result.IsRawJSON = true
+		result.DecodedType = "gojay.EmbeddedJSON"
+		result.DecodingCall = "EmbeddedJSON(&value)"
+		result.ValueStore = "[]byte(value)"
+		result.EncodingMethod = "AddEmbeddedJSON"
+		result.ValueEncode = "(*gojay.EmbeddedJSON)(&" + item + ")"
+	case isCollectionType(element):
+		helperType, err := s.generateCompositeType(fieldName, element)
+		if err != nil {
+			return err
+		}
+		result.ValueInit = helperType + "{}"
+		result.ValueStore = element + "(value)"
+		result.ValueEncode = helperType + "(" + item + ")"
+		result.DecodingCall = "Array(&value)"
+		result.EncodingMethod = "Array"
+		if strings.HasPrefix(element, "map[") {
+			result.DecodingCall = "Object(value)"
+			result.EncodingMethod = "Object"
+		}
+	case isBaseType(element):
+		methodType := builtinMethodType(element)
+		result.DecodingCall = firstLetterToUppercase(methodType) + "(&value)"
+		result.EncodingMethod = firstLetterToUppercase(methodType)
+		if methodType != element {
+			result.DecodedType = methodType
+			result.ValueStore = element + "(value)"
+			result.ValueEncode = methodType + "(" + item + ")"
+		}
+	case s.baseType(element) != "":
+		baseType := builtinMethodType(s.baseType(element))
+		result.DecodedType = baseType
+		result.DecodingCall = firstLetterToUppercase(baseType) + "(&value)"
+		result.ValueStore = element + "(value)"
+		result.EncodingMethod = firstLetterToUppercase(baseType)
+		result.ValueEncode = baseType + "(" + item + ")"
+	// generic type instances, i.e [][]Page[T], are not supported as elements
+	case s.Type(element) != nil && s.Type(element).IsStruct && !strings.Contains(element, "["):
+		if err := s.generateStructCode(element); err != nil {
+			return err
+		}
+		result.DecodingCall = "Object(&value)"
+		result.EncodingMethod = "Object"
+		result.ValueEncode = "item"
+		if !result.IsPointer {
+			result.ValueEncode = "&item"
+		}
+	default:
+		return fmt.Errorf("Unsupported element type %s for field %s", element, fieldName)
+	}
+	return nil
+}
+
+// generateSliceType generates the helper type of a slice of builtin, named or struct types
+func (s *Struct) generateSliceType(fieldName, typeName string) (*Field, error) {
+	component := typeName[2:]
+	sliceField, err := NewField(s, &toolbox.FieldInfo{
+		Name:               fieldName,
+		TypeName:           typeName,
+		IsSlice:            true,
+		ComponentType:      normalizeTypeName(component),
+		IsPointerComponent: strings.HasPrefix(component, "*"),
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case isBaseType(sliceField.ComponentType):
+		err = s.generatePrimitiveArray(sliceField)
+	case sliceField.BaseType != "":
+		err = s.generateNamedTypeArray(sliceField)
+	case s.Type(sliceField.ComponentType) != nil:
+		err = s.generateObjectArray(sliceField)
+	default:
+		err = fmt.Errorf("Unsupported element type %s for field %s", component, fieldName)
+	}
+	return sliceField, err
+}
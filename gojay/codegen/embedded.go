@@ -0,0 +1,240 @@
+package codegen
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/viant/toolbox"
+)
+
+// jsonField represents a field of the JSON object of a struct, embedded struct fields are promoted as in encoding/json
+type jsonField struct {
+	*toolbox.FieldInfo
+	Key    string
+	Tagged bool                 //key is given by the tag
+	Index  []int                //field index sequence, as reflect.StructField.Index
+	Path   []*toolbox.FieldInfo //embedded fields the field is promoted through
+}
+
+// embeddedStruct represents a struct whose fields are visited when looking for JSON fields
+type embeddedStruct struct {
+	*toolbox.TypeInfo
+	Index []int
+	Path  []*toolbox.FieldInfo
+}
+
+// jsonFields returns the JSON fields of a struct following encoding/json rules:
+// unexported and "-" fields are ignored, untagged embedded structs are flattened, a field hides the fields with the same key
+// at a deeper depth, at the same depth a tagged field wins over untagged ones and the other conflicting fields are dropped.
+// The code of the embedded structs is generated along.
+func (s *Struct) jsonFields(typeInfo *toolbox.TypeInfo) ([]*jsonField, error) {
+	var current = []*embeddedStruct{}
+	var next = []*embeddedStruct{{TypeInfo: typeInfo}}
+	var count, nextCount = map[string]int{}, map[string]int{typeInfo.Name: 1}
+	var visited = map[string]bool{}
+	var fields = []*jsonField{}
+	for len(next) > 0 {
+		current, next = next, []*embeddedStruct{}
+		count, nextCount = nextCount, map[string]int{}
+		for _, embedded := range current {
+			if visited[embedded.Name] {
+				continue
+			}
+			visited[embedded.Name] = true
+			for i, field := range embedded.Fields() {
+				if isSkipable(s.options, field) || field.TypeName == fieldSetType {
+					continue
+				}
+				var fieldType = s.Type(normalizeTypeName(field.TypeName))
+				var isStruct = fieldType != nil && fieldType.IsStruct
+				if field.IsAnonymous {
+					// embedded fields of unexported non struct types are ignored
+					if !isExported(embeddedFieldName(field)) && !isStruct {
+						continue
+					}
+				} else if !isExported(field.Name) {
+					continue
+				}
+				var index = append(append([]int{}, embedded.Index...), i)
+				var key = getJSONTagName(s.options, field)
+				var tagged = key != ""
+				if !tagged {
+					key = field.Name
+				}
+				if !field.IsAnonymous || tagged || !isStruct {
+					var result = &jsonField{FieldInfo: field, Key: key, Tagged: tagged, Index: index, Path: embedded.Path}
+					if field.IsAnonymous {
+						// a tagged or non struct embedded field is a regular field
+						anonymous := *field
+						anonymous.IsAnonymous = false
+						anonymous.Name = embeddedFieldName(field)
+						result.FieldInfo = &anonymous
+						if !tagged {
+							result.Key = anonymous.Name
+						}
+					}
+					fields = append(fields, result)
+					if count[embedded.Name] > 1 {
+						// a struct embedded more than once at the same depth has conflicting fields, which are dropped
+						fields = append(fields, result)
+					}
+					continue
+				}
+				if err := s.generateStructCode(fieldType.Name); err != nil {
+					return nil, err
+				}
+				nextCount[fieldType.Name]++
+				if nextCount[fieldType.Name] == 1 {
+					var path = append(append([]*toolbox.FieldInfo{}, embedded.Path...), field)
+					next = append(next, &embeddedStruct{TypeInfo: fieldType, Index: index, Path: path})
+				}
+			}
+		}
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		if fields[i].Key != fields[j].Key {
+			return fields[i].Key < fields[j].Key
+		}
+		if len(fields[i].Index) != len(fields[j].Index) {
+			return len(fields[i].Index) < len(fields[j].Index)
+		}
+		if fields[i].Tagged != fields[j].Tagged {
+			return fields[i].Tagged
+		}
+		return lessIndex(fields[i].Index, fields[j].Index)
+	})
+	var result = []*jsonField{}
+	for i := 0; i < len(fields); {
+		var j = i + 1
+		for j < len(fields) && fields[j].Key == fields[i].Key {
+			j++
+		}
+		// the first field dominates unless the next one has the same depth and is tagged the same way
+		if j-i == 1 || len(fields[i].Index) != len(fields[i+1].Index) || fields[i].Tagged != fields[i+1].Tagged {
+			result = append(result, fields[i])
+		}
+		i = j
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return lessIndex(result[i].Index, result[j].Index)
+	})
+	return result, nil
+}
+
+// newJSONField returns the field to generate the code of a JSON field, promoted fields are accessed through their embedded fields
+func (s *Struct) newJSONField(jsonField *jsonField) (*Field, *toolbox.TypeInfo, error) {
+	fieldTypeInfo := s.Type(normalizeTypeName(jsonField.TypeName))
+	field, err := NewField(s, jsonField.FieldInfo, fieldTypeInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+	field.Key = jsonField.Key
+	if len(jsonField.Path) > 0 {
+		field.Accessor = jsonField.accessor(s.Alias)
+		field.Mutator = field.Accessor
+		field.Var = firstLetterToLowercase(strings.Replace(strings.TrimPrefix(field.Accessor, s.Alias+"."), ".", "", -1))
+	}
+	return field, fieldTypeInfo, nil
+}
+
+// generateEmbeddedInit generates the allocation of the nil embedded pointers the decoded keys are promoted through
+func (s *Struct) generateEmbeddedInit(fields []*jsonField) (string, error) {
+	var cases = []*embeddedInitCase{}
+	var casesByPath = map[string]*embeddedInitCase{}
+	for _, jsonField := range fields {
+		pointers := jsonField.pointerPath(s.Alias)
+		if len(pointers) == 0 {
+			continue
+		}
+		path := strings.Join(pointers, ",")
+		if initCase, ok := casesByPath[path]; ok {
+			initCase.Keys += `, "` + jsonField.Key + `"`
+			continue
+		}
+		var initCode = []string{}
+		var selector = s.Alias
+		for _, embedded := range jsonField.Path {
+			selector += "." + embeddedFieldName(embedded)
+			if !embedded.IsPointer {
+				continue
+			}
+			field, err := NewField(s, embedded, nil)
+			if err != nil {
+				return "", err
+			}
+			field.Accessor = selector
+			code, err := expandBlockTemplate(embeddedStructInit, field)
+			if err != nil {
+				return "", err
+			}
+			initCode = append(initCode, code)
+		}
+		casesByPath[path] = &embeddedInitCase{Keys: `"` + jsonField.Key + `"`, Init: strings.Join(initCode, "\n")}
+		cases = append(cases, casesByPath[path])
+	}
+	if len(cases) == 0 {
+		return "", nil
+	}
+	return expandBlockTemplate(embeddedKeysInit, cases)
+}
+
+// embeddedInitCase represents the keys decoded through the same embedded pointers
+type embeddedInitCase struct {
+	Keys string
+	Init string
+}
+
+// accessor returns the selector of the field from the receiver alias, i.e m.BaseId.Id
+func (f *jsonField) accessor(alias string) string {
+	var selector = alias
+	for _, embedded := range f.Path {
+		selector += "." + embeddedFieldName(embedded)
+	}
+	return selector + "." + f.Name
+}
+
+// pointerPath returns the selectors of the embedded pointers the field is promoted through, i.e m.BaseId
+func (f *jsonField) pointerPath(alias string) []string {
+	var result = []string{}
+	var selector = alias
+	for _, embedded := range f.Path {
+		selector += "." + embeddedFieldName(embedded)
+		if embedded.IsPointer {
+			result = append(result, selector)
+		}
+	}
+	return result
+}
+
+// embeddedFieldName returns the name of an embedded field, i.e Time for *time.Time
+func embeddedFieldName(field *toolbox.FieldInfo) string {
+	var name = field.Name
+	if index := strings.LastIndex(name, "."); index != -1 {
+		name = name[index+1:]
+	}
+	// type arguments are not part of the field name
+	if index := strings.Index(name, "["); index > 0 {
+		name = name[:index]
+	}
+	return name
+}
+
+func isExported(name string) bool {
+	r, _ := utf8.DecodeRuneInString(name)
+	return unicode.IsUpper(r)
+}
+
+func lessIndex(a, b []int) bool {
+	for i := range a {
+		if i >= len(b) {
+			return false
+		}
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
@@ -42,8 +42,49 @@ type Field struct {
 	IsAnonymous     bool
 	IsPointer       bool
 	IsSlice         bool
+	IsMap           bool
 
 	GojayMethod string
+
+	Discriminator        string       //discriminator key of a tagged union interface field
+	DiscriminatorPointer string       //JSON Pointer to the discriminator key
+	UnionTypes           []*UnionType //concrete types of a tagged union interface field
+
+	BaseType  string //builtin type of a named type, i.e string for type Status string
+	Enum      string //enum encoding, text uses MarshalText/UnmarshalText, string uses String() and ParseFunc
+	ParseFunc string //function parsing an enum from its string
+	IsGeneric bool   //type depends on type parameters or is a generic type instance, i.e T, Page[T] or Page[Item]
+
+	TypeParam       string //type parameter of the field values, i.e T for a T, *T, []T or []*T field
+	TypeParamHelper string //suffix of the functions encoding and decoding the values of TypeParam, i.e PageT for encodePageT and decodePageT
+
+	AsString   bool   //number or bool encoded as a string, with the string tag option
+	ParseCall  string //parses the string of an AsString field
+	FormatCall string //formats an AsString field as a string
+	EncodeFunc string //user provided encoding function of the codec tag option
+	DecodeFunc string //user provided decoding function of the codec tag option
+	Codec      string //codec given by the tag, i.e ids.ID for ids.EncodeID and ids.DecodeID
+
+	CompositeType string //array, nested slice or pointer to slice type without the field pointer, i.e [][]float64 for a [][]float64 or *[][]float64 field
+}
+
+const (
+	enumText   = "text"
+	enumString = "string"
+)
+
+// builtinType returns the builtin type of the field, i.e string for type Status string
+func (f *Field) builtinType() string {
+	if f.BaseType != "" {
+		return f.BaseType
+	}
+	return f.Type
+}
+
+//UnionType represents a concrete type of a tagged union interface field
+type UnionType struct {
+	Type          string
+	Discriminator string
 }
 
 //NewField returns a new field
@@ -55,7 +96,7 @@ func NewField(owner *Struct, field *toolbox.FieldInfo, fieldType *toolbox.TypeIn
 		RawType:            field.TypeName,
 		IsPointer:          field.IsPointer,
 		Key:                getJSONKey(owner.options, field),
-		Receiver:           owner.Alias + " *" + owner.TypeInfo.Name,
+		Receiver:           owner.Alias + " *" + owner.TypeInfo.Name + owner.TypeArgs,
 		Type:               typeName,
 		Mutator:            owner.Alias + "." + field.Name,
 		Accessor:           owner.Alias + "." + field.Name,
@@ -65,11 +106,24 @@ func NewField(owner *Struct, field *toolbox.FieldInfo, fieldType *toolbox.TypeIn
 		Init:               fmt.Sprintf("%v{}", typeName),
 		TimeLayout:         "time.RFC3339",
 		IsSlice:            field.IsSlice,
+		IsMap:              field.IsMap,
 		PoolName:           getPoolName(field.TypeName),
 		Alias:              owner.Alias,
 		Reset:              "nil",
 	}
 	var err error
+	if compositeType := strings.TrimPrefix(field.TypeName, "*"); isCompositeFieldType(compositeType, field.IsPointer) {
+		result.CompositeType = compositeType
+	}
+	if field.IsSlice && result.ComponentType == "" {
+		// toolbox does not resolve the component of a slice of generic types, i.e []Page[T]
+		component := strings.TrimPrefix(field.TypeName, "[]")
+		result.IsPointerComponent = strings.HasPrefix(component, "*")
+		result.ComponentType = normalizeTypeName(component)
+	}
+	if result.IsGeneric, result.TypeParam = owner.genericFieldType(field.Name); result.TypeParam != "" {
+		result.TypeParamHelper = owner.Name + result.TypeParam
+	}
 	if field.IsPointer {
 		result.DereferenceModifier = "*"
 		result.Init = "&" + result.Init
@@ -77,7 +131,11 @@ func NewField(owner *Struct, field *toolbox.FieldInfo, fieldType *toolbox.TypeIn
 		result.PointerModifier = "&"
 
 	}
-	if field.IsSlice {
+	if result.CompositeType != "" {
+		result.HelperType = compositeHelperTypeName(result.CompositeType)
+	} else if field.IsMap {
+		result.HelperType = getMapHelperTypeName(field.KeyTypeName, field.ValueTypeName)
+	} else if field.IsSlice {
 		result.HelperType = getSliceHelperTypeName(field.ComponentType, field.IsPointerComponent)
 		result.PoolName = getPoolName(field.ComponentType)
 	} else if fieldType != nil {
@@ -89,6 +147,12 @@ func NewField(owner *Struct, field *toolbox.FieldInfo, fieldType *toolbox.TypeIn
 	} else if options := getTagOptions(field.Tag, "timeFormat"); len(options) > 0 {
 		result.TimeLayout = wrapperIfNeeded(toolbox.DateFormatToLayout(options[0]), `"`)
 	}
+	result.Discriminator, result.UnionTypes = getUnionTypes(field.Tag)
+	result.Enum = getGojayTagOption(field.Tag, "enum")
+	result.ParseFunc = getGojayTagOption(field.Tag, "parse")
+	if result.Discriminator != "" {
+		result.DiscriminatorPointer = "/" + strings.Replace(strings.Replace(result.Discriminator, "~", "~0", -1), "/", "~1", -1)
+	}
 	if strings.Contains(field.Tag, "omitempty") {
 		result.OmitEmpty = "OmitEmpty"
 	}
@@ -107,10 +171,30 @@ func NewField(owner *Struct, field *toolbox.FieldInfo, fieldType *toolbox.TypeIn
 	if encodingMethod == "" {
 		encodingMethod = result.Type
 	}
+	if field.IsSlice {
+		result.BaseType = owner.baseType(field.ComponentType)
+	} else if !field.IsMap {
+		result.BaseType = owner.baseType(typeName)
+	}
+	if result.BaseType != "" {
+		// named types are decoded and encoded with the methods of their builtin type
+		encodingMethod = result.BaseType
+	}
 	result.DecodingMethod = firstLetterToUppercase(encodingMethod)
 	result.EncodingMethod = firstLetterToUppercase(encodingMethod)
+	if !field.IsSlice && !field.IsMap && hasTagOption(field.Tag, owner.options.TagName, "string") {
+		// as in encoding/json, the string option only applies to numbers and bools
+		_, _, result.AsString = stringCodec(result.builtinType(), "")
+	}
+	if result.Codec = getGojayTagOption(field.Tag, "codec"); result.Codec != "" {
+		result.EncodeFunc, result.DecodeFunc = codecFuncs(result.Codec)
+	}
 
-	switch typeName {
+	var resetType = typeName
+	if result.BaseType != "" && !field.IsSlice {
+		resetType = result.BaseType
+	}
+	switch resetType {
 	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
 		result.Reset = "0"
 	case "float32", "float64":
@@ -121,7 +205,7 @@ func NewField(owner *Struct, field *toolbox.FieldInfo, fieldType *toolbox.TypeIn
 	case "bool":
 		result.Reset = "false"
 	default:
-		if field.IsSlice && owner.Type(field.ComponentType) != nil {
+		if field.IsSlice && result.CompositeType == "" && owner.Type(field.ComponentType) != nil {
 			var itemPointer = ""
 			if !field.IsPointerComponent {
 				itemPointer = "&"
@@ -146,9 +230,12 @@ func NewField(owner *Struct, field *toolbox.FieldInfo, fieldType *toolbox.TypeIn
 		}
 
 	}
-	if field.IsSlice || field.IsPointer {
+	if field.IsSlice || field.IsMap || field.IsPointer {
 		result.Reset = "nil"
 	}
+	if isArrayType(result.CompositeType) && !field.IsPointer {
+		result.Reset = result.CompositeType + "{}"
+	}
 
 	if result.IsPointerComponent {
 		result.ComponentInit = "&" + result.ComponentType + "{}"
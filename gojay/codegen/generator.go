@@ -1,8 +1,11 @@
 package codegen
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"go/format"
+	"go/types"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -14,26 +17,52 @@ const gojayPackage = "github.com/francoispqt/gojay"
 
 // Generator holds the content to generate the gojay code
 type Generator struct {
-	fileInfo      *toolbox.FileSetInfo
-	types         map[string]string
-	structTypes   map[string]string
-	sliceTypes    map[string]string
-	pooledObjects map[string]string
-	poolInit      map[string]string
-	imports       map[string]bool
-	filedInit     []string
-	Pkg           string
-	Code          string
-	Init          string
-	Imports       string
-	options       *Options
+	fileInfo         *toolbox.FileSetInfo
+	types            map[string]string
+	structTypes      map[string]string
+	sliceTypes       map[string]string
+	mapTypes         map[string]string
+	pooledObjects    map[string]string
+	poolInit         map[string]string
+	imports          map[string]bool
+	helperFiles      map[string]string       //source file of the type which needed a slice or map helper type
+	file             string                  //source file of the type being generated
+	typesPkg         *types.Package          //source package type checked by go/types, nil if it declares no generic type
+	generics         map[string]*genericType //generic struct types of the source package
+	typeParamTypes   map[string]string       //functions encoding and decoding the values of type parameters
+	genericTypes     map[string]bool         //struct types whose code uses type parameters
+	jsonIncompatible map[string]bool         //struct types encoded differently than by encoding/json
+	references       map[string][]string     //struct types referenced by the fields of a struct type
+	filedInit        []string
+	Pkg              string
+	Code             string
+	Init             string
+	Imports          string
+	BuildTag         string
+	options          *Options
 }
 
 // Returns the type from the the fileInfo
 func (g *Generator) Type(typeName string) *toolbox.TypeInfo {
+	// generic types are looked up without their type arguments, i.e Page for Page[T]
+	if index := strings.Index(typeName, "["); index > 0 {
+		typeName = typeName[:index]
+	}
 	return g.fileInfo.Type(typeName)
 }
 
+// baseType returns the builtin type a named type is derived from, or an empty string if typeName is not a named builtin type
+func (g *Generator) baseType(typeName string) string {
+	typeInfo := g.Type(typeName)
+	if typeInfo == nil || !typeInfo.IsDerived {
+		return ""
+	}
+	if isBaseType(typeInfo.Derived) {
+		return typeInfo.Derived
+	}
+	return g.baseType(typeInfo.Derived)
+}
+
 // addImport adds an import package to be printed on the generated code
 func (g *Generator) addImport(pkg string) {
 	g.imports[`"`+pkg+`"`] = true
@@ -46,7 +75,15 @@ func (g *Generator) init() {
 	g.pooledObjects = map[string]string{}
 	g.structTypes = map[string]string{}
 	g.sliceTypes = map[string]string{}
+	g.mapTypes = map[string]string{}
 	g.poolInit = map[string]string{}
+	g.helperFiles = map[string]string{}
+	g.typeParamTypes = map[string]string{}
+	g.genericTypes = map[string]bool{}
+	g.jsonIncompatible = map[string]bool{}
+	g.references = map[string][]string{}
+	g.Init = ""
+	g.Code = ""
 	g.addImport(gojayPackage)
 	// if we want pools, add the sync package right away
 	if g.options.PoolObjects {
@@ -69,6 +106,10 @@ func NewGenerator(options *Options) *Generator {
 
 // Generate generates the gojay implementation code
 func (g *Generator) Generate() error {
+	// without types, we look for the types annotated with //gojay:generate
+	if len(g.options.Types) == 0 {
+		return g.generateAnnotated()
+	}
 	// first we read the code from which we should find the types
 	if err := g.readPackageCode(g.options.Source); err != nil {
 		return err
@@ -81,30 +122,110 @@ func (g *Generator) Generate() error {
 		}
 	}
 
-	//
-	g.Imports = strings.Join(toolbox.MapKeysToStringSlice(g.imports), "\n")
 	return g.writeCode()
 }
 
 func (g *Generator) writeCode() error {
+	code, err := g.generateCode(func(string) bool { return true })
+	if err != nil {
+		return err
+	}
+
+	// code destination is empty, we just print to stdout
+	if g.options.Dest == "" {
+		if g.options.Check {
+			return errors.New("Dest is required to check generated code")
+		}
+		if g.options.Tests {
+			return errors.New("Dest is required to generate tests")
+		}
+		fmt.Print(string(code))
+		return nil
+	}
+
+	var stale = []string{}
+	upToDate, err := g.writeFile(g.options.Dest, code)
+	if err != nil {
+		return err
+	}
+	if !upToDate {
+		stale = append(stale, g.options.Dest)
+	}
+	if g.options.Tests {
+		testCode, err := g.generateTestCode(func(string) bool { return true })
+		if err != nil {
+			return err
+		}
+		filename := testFileName(g.options.Dest)
+		if upToDate, err = g.writeFile(filename, testCode); err != nil {
+			return err
+		}
+		if !upToDate {
+			stale = append(stale, filename)
+		}
+	}
+	if len(stale) > 0 {
+		return fmt.Errorf("generated code is out of date: %v", strings.Join(stale, ", "))
+	}
+	return nil
+}
+
+// generateCode generates the code of the types and helper types declared or needed by the source files matched by hasFile
+func (g *Generator) generateCode(hasFile func(file string) bool) ([]byte, error) {
 	var generatedCode = []string{}
+	g.Init = ""
+	g.BuildTag = ""
+	// unused imports are removed once the code is generated
+	g.Imports = strings.Join(toolbox.MapKeysToStringSlice(g.imports), "\n")
 
 	for _, key := range sortedKeys(g.pooledObjects) {
+		if !hasFile(g.typeFile(key)) {
+			continue
+		}
 		code := g.pooledObjects[key]
 		generatedCode = append(generatedCode, code)
 	}
 	generatedCode = append(generatedCode, "")
 	for _, key := range sortedKeys(g.sliceTypes) {
+		if !hasFile(g.helperFiles[key]) {
+			continue
+		}
 		code := g.sliceTypes[key]
 		generatedCode = append(generatedCode, code)
 	}
 	generatedCode = append(generatedCode, "")
+	for _, key := range sortedKeys(g.mapTypes) {
+		if !hasFile(g.helperFiles[key]) {
+			continue
+		}
+		code := g.mapTypes[key]
+		generatedCode = append(generatedCode, code)
+	}
+	generatedCode = append(generatedCode, "")
+	for _, key := range sortedKeys(g.typeParamTypes) {
+		if !hasFile(g.helperFiles[key]) {
+			continue
+		}
+		code := g.typeParamTypes[key]
+		generatedCode = append(generatedCode, code)
+		g.BuildTag = genericBuildTag
+	}
+	generatedCode = append(generatedCode, "")
 	for _, key := range sortedKeys(g.structTypes) {
+		if !hasFile(g.typeFile(key)) {
+			continue
+		}
 		code := g.structTypes[key]
 		generatedCode = append(generatedCode, code)
+		if g.genericTypes[key] {
+			g.BuildTag = genericBuildTag
+		}
 	}
 
 	for _, key := range sortedKeys(g.poolInit) {
+		if !hasFile(g.typeFile(key)) {
+			continue
+		}
 		code := g.poolInit[key]
 		if g.Init != "" {
 			g.Init += "\n"
@@ -115,22 +236,33 @@ func (g *Generator) writeCode() error {
 
 	expandedCode, err := expandBlockTemplate(fileCode, g)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	code, err := format.Source([]byte(expandedCode))
-
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return removeUnusedImports(code)
+}
 
-	// code destination is empty, we just print to stdout
-	if g.options.Dest == "" {
-		fmt.Print(string(code))
-		return nil
+// typeFile returns the source file declaring typeName
+func (g *Generator) typeFile(typeName string) string {
+	if typeInfo := g.Type(typeName); typeInfo != nil {
+		return typeInfo.FileName
 	}
+	return ""
+}
 
-	return ioutil.WriteFile(g.options.Dest, code, 0644)
+// writeFile writes code to filename, in check mode it only reports whether filename is up to date
+func (g *Generator) writeFile(filename string, code []byte) (bool, error) {
+	if existing, err := ioutil.ReadFile(filename); err == nil && bytes.Equal(existing, code) {
+		return true, nil
+	}
+	if g.options.Check {
+		return false, nil
+	}
+	return true, ioutil.WriteFile(filename, code, 0644)
 }
 
 func (g *Generator) generatePrimitiveArray(field *Field) error {
@@ -140,6 +272,20 @@ func (g *Generator) generatePrimitiveArray(field *Field) error {
 	}
 	code, err := expandBlockTemplate(baseTypeSlice, field)
 	g.sliceTypes[key] = code
+	g.helperFiles[key] = g.file
+	return err
+}
+
+func (g *Generator) generateNamedTypeArray(field *Field) error {
+	if _, ok := g.sliceTypes[field.RawComponentType]; ok {
+		return nil
+	}
+	code, err := expandBlockTemplate(namedTypeSlice, field)
+	if err != nil {
+		return err
+	}
+	g.sliceTypes[field.RawComponentType] = code
+	g.helperFiles[field.RawComponentType] = g.file
 	return err
 }
 
@@ -156,6 +302,7 @@ func (g *Generator) generateObjectArray(field *Field) error {
 		return err
 	}
 	g.sliceTypes[field.RawComponentType] = code
+	g.helperFiles[field.RawComponentType] = g.file
 	return err
 }
 
@@ -169,6 +316,7 @@ func (g *Generator) generateTimeArray(field *Field) error {
 		return err
 	}
 	g.sliceTypes[field.RawComponentType] = code
+	g.helperFiles[field.RawComponentType] = g.file
 	return err
 }
 
@@ -182,11 +330,13 @@ func (g *Generator) generateTypedArray(field *Field) error {
 		return err
 	}
 	g.sliceTypes[field.RawComponentType] = code
+	g.helperFiles[field.RawComponentType] = g.file
 	return err
 }
 
 func (g *Generator) generatePool(structType string) error {
-	if !g.options.PoolObjects {
+	// a pool can not allocate a generic type without its type arguments
+	if !g.options.PoolObjects || g.generics[structType] != nil {
 		return nil
 	}
 	var err error
@@ -205,14 +355,23 @@ func (g *Generator) generatePool(structType string) error {
 func (g *Generator) generateStructCode(structType string) error {
 	structType = normalizeTypeName(structType)
 	typeInfo := g.Type(structType)
-	if typeInfo == nil {
+	if typeInfo == nil || typeInfo.IsInterface || g.baseType(structType) != "" {
 		return nil
 	}
+	// type arguments are dropped, i.e Page[T] is generated as Page
+	structType = typeInfo.Name
+	if g.generics[structType] != nil {
+		g.genericTypes[structType] = true
+	}
 	if _, hasCode := g.structTypes[structType]; hasCode {
 		return nil
 	}
 	g.generatePool(structType)
 
+	// helper types needed by the struct are generated along with the struct code
+	var file = g.file
+	g.file = typeInfo.FileName
+	defer func() { g.file = file }()
 	aStruct := NewStruct(typeInfo, g)
 	code, err := aStruct.Generate()
 
@@ -236,14 +395,19 @@ func (g *Generator) readPackageCode(pkgPath string) error {
 		return err
 	}
 
+	var dir = p
 	if !f.IsDir() {
 		g.Pkg = filepath.Dir(p)
-		dir, _ := filepath.Split(p)
-		g.fileInfo, err = toolbox.NewFileSetInfo(dir)
-
+		dir, _ = filepath.Split(p)
 	} else {
 		g.Pkg = filepath.Base(p)
-		g.fileInfo, err = toolbox.NewFileSetInfo(p)
+	}
+	if g.fileInfo, err = toolbox.NewFileSetInfo(dir); err != nil {
+		return err
+	}
+	// toolbox does not read type parameters, generic types and their instances are resolved with go/types
+	if g.typesPkg, g.generics, err = readGenericTypes(dir); err != nil {
+		return err
 	}
 
 	// if Pkg flag is set use it
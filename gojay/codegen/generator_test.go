@@ -3,8 +3,11 @@ package codegen
 import (
 	"github.com/stretchr/testify/assert"
 	"github.com/viant/toolbox"
+	"io/ioutil"
 	"log"
+	"os"
 	"path"
+	"strings"
 	"testing"
 )
 
@@ -23,6 +26,7 @@ func TestGenerator_Generate(t *testing.T) {
 				Source: path.Join(parent, "basic_struct"),
 				Types:  []string{"Message"},
 				Dest:   path.Join(parent, "basic_struct", "encoding.go"),
+				Tests:  true,
 			},
 		},
 
@@ -54,6 +58,84 @@ func TestGenerator_Generate(t *testing.T) {
 				TagName:     "json",
 			},
 		},
+		{
+			description: "struct with tagged union interface field code generation",
+			options: &Options{
+				Source:  path.Join(parent, "union_struct"),
+				Types:   []string{"Message"},
+				Dest:    path.Join(parent, "union_struct", "encoding.go"),
+				TagName: "json",
+				Tests:   true,
+			},
+		},
+		{
+			description: "struct with named types and enums code generation",
+			options: &Options{
+				Source:  path.Join(parent, "named_struct"),
+				Types:   []string{"Message"},
+				Dest:    path.Join(parent, "named_struct", "encoding.go"),
+				TagName: "json",
+				Tests:   true,
+			},
+		},
+		{
+			description: "embedded struct flattening code generation",
+			options: &Options{
+				Source:  path.Join(parent, "embedded_json"),
+				Types:   []string{"Message", "Twice"},
+				Dest:    path.Join(parent, "embedded_json", "encoding.go"),
+				TagName: "json",
+				Tests:   true,
+			},
+		},
+		{
+			description: "string option and codec code generation",
+			options: &Options{
+				Source:  path.Join(parent, "codec_struct"),
+				Types:   []string{"Message"},
+				Dest:    path.Join(parent, "codec_struct", "encoding.go"),
+				TagName: "json",
+				Tests:   true,
+			},
+		},
+		{
+			description: "composite types code generation",
+			options: &Options{
+				Source:  path.Join(parent, "composite_struct"),
+				Types:   []string{"Message", "Batch"},
+				Dest:    path.Join(parent, "composite_struct", "encoding.go"),
+				TagName: "json",
+				Tests:   true,
+			},
+		},
+		{
+			description: "field presence code generation",
+			options: &Options{
+				Source:  path.Join(parent, "presence_struct"),
+				Types:   []string{"Patch"},
+				Dest:    path.Join(parent, "presence_struct", "encoding.go"),
+				TagName: "json",
+				Tests:   true,
+			},
+		},
+		{
+			description: "generic struct code generation",
+			options: &Options{
+				Source:  path.Join(parent, "generic_struct"),
+				Types:   []string{"Message"},
+				Dest:    path.Join(parent, "generic_struct", "encoding.go"),
+				TagName: "json",
+				Tests:   true,
+			},
+		},
+		{
+			description: "annotated types code generation in multiple packages",
+			options: &Options{
+				Source:  path.Join(parent, "annotation") + "/...",
+				TagName: "json",
+				Tests:   true,
+			},
+		},
 	}
 
 	for _, useCase := range useCases {
@@ -70,3 +152,97 @@ func TestGenerator_Generate(t *testing.T) {
 	}
 
 }
+
+func TestGenerator_Check(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gojay")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	var source = `package check
+
+//gojay:generate
+type Message struct {
+	Id int
+}
+`
+	assert.Nil(t, ioutil.WriteFile(path.Join(dir, "message.go"), []byte(source), 0644))
+
+	check := &Options{Source: dir, TagName: "json", Check: true}
+	assert.NotNil(t, NewGenerator(check).Generate(), "generated code is missing")
+	_, err = os.Stat(path.Join(dir, "message_gojay.go"))
+	assert.True(t, os.IsNotExist(err), "check mode should not write code")
+
+	assert.Nil(t, NewGenerator(&Options{Source: dir, TagName: "json"}).Generate())
+	assert.Nil(t, NewGenerator(check).Generate(), "generated code is up to date")
+
+	source = strings.Replace(source, "Id int", "Id   int\n\tName string", 1)
+	assert.Nil(t, ioutil.WriteFile(path.Join(dir, "message.go"), []byte(source), 0644))
+	assert.NotNil(t, NewGenerator(check).Generate(), "generated code is stale")
+
+	// without annotation, the generated file is an orphan
+	assert.Nil(t, ioutil.WriteFile(path.Join(dir, "message.go"), []byte(strings.Replace(source, "//gojay:generate\n", "", 1)), 0644))
+	assert.NotNil(t, NewGenerator(check).Generate(), "generated code is an orphan")
+	assert.Nil(t, NewGenerator(&Options{Source: dir, TagName: "json"}).Generate())
+	_, err = os.Stat(path.Join(dir, "message_gojay.go"))
+	assert.True(t, os.IsNotExist(err), "orphan generated code should be removed")
+}
+
+func TestReadGenericTypes(t *testing.T) {
+	var useCases = []struct {
+		description string
+		files       map[string]string
+		hasError    bool
+	}{
+		{
+			description: "generated methods not generated yet",
+			files: map[string]string{"page.go": `package page
+
+import "github.com/francoispqt/gojay"
+
+type Page[T any] struct {
+	Items []T
+}
+
+var _ gojay.MarshalerJSONObject = &Page[int]{}
+`},
+		},
+		{
+			description: "type check error",
+			files: map[string]string{"page.go": `package page
+
+type Page[T any] struct {
+	Items []T
+	Total Unknown
+}
+`},
+			hasError: true,
+		},
+		{
+			description: "generic types in several packages",
+			files: map[string]string{
+				"page.go": "package page\n\ntype Page[T any] struct {\n\tItems []T\n}\n",
+				"main.go": "//go:build ignore\n\npackage main\n\ntype Box[T any] struct {\n\tValue T\n}\n",
+			},
+			hasError: true,
+		},
+	}
+	for _, useCase := range useCases {
+		dir, err := ioutil.TempDir("", "gojay")
+		if !assert.Nil(t, err, useCase.description) {
+			continue
+		}
+		for name, source := range useCase.files {
+			assert.Nil(t, ioutil.WriteFile(path.Join(dir, name), []byte(source), 0644), useCase.description)
+		}
+		_, generics, err := readGenericTypes(dir)
+		os.RemoveAll(dir)
+		if useCase.hasError {
+			assert.NotNil(t, err, useCase.description)
+			continue
+		}
+		if assert.Nil(t, err, useCase.description) {
+			assert.NotNil(t, generics["Page"], useCase.description)
+		}
+	}
+}
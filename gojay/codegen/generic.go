@@ -0,0 +1,377 @@
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"sort"
+	"strings"
+)
+
+const genericBuildTag = "//go:build go1.18"
+
+// genericType is a generic struct type of the source package with the type arguments it is instantiated with in the package
+type genericType struct {
+	*types.Named
+	arguments [][]types.Type //type arguments of each type parameter, i.e [[int Item] [string]] for Envelope[int, string] and Envelope[Item, string]
+}
+
+// addArgument adds a type argument of the type parameter at index, unless it is already known
+func (t *genericType) addArgument(index int, argument types.Type) {
+	for _, known := range t.arguments[index] {
+		if types.Identical(known, argument) {
+			return
+		}
+	}
+	t.arguments[index] = append(t.arguments[index], argument)
+}
+
+// readGenericTypes type checks the package in dir with go/types and returns its generic types,
+// the package is not type checked if it declares no generic type
+func readGenericTypes(dir string) (*types.Package, map[string]*genericType, error) {
+	fileSet := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fileSet, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	var result = map[string]*genericType{}
+	var names = []string{}
+	for name, pkg := range pkgs {
+		if hasTypeParams(pkg) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, result, nil
+	}
+	if len(names) > 1 {
+		sort.Strings(names)
+		return nil, nil, fmt.Errorf("found generic types in packages %v of %v, expected a single package", strings.Join(names, ", "), dir)
+	}
+	var pkg = pkgs[names[0]]
+	var fileNames = []string{}
+	for fileName := range pkg.Files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+	var files = []*ast.File{}
+	for _, fileName := range fileNames {
+		files = append(files, pkg.Files[fileName])
+	}
+	info := &types.Info{Instances: map[*ast.Ident]types.Instance{}}
+	var typeErrors = []string{}
+	config := types.Config{
+		Importer: importer.ForCompiler(fileSet, "source", nil),
+		Error: func(err error) {
+			if !isMissingGeneratedMethod(err) {
+				typeErrors = append(typeErrors, err.Error())
+			}
+		},
+	}
+	typesPkg, _ := config.Check(pkg.Name, fileSet, files, info)
+	if len(typeErrors) > 0 {
+		return nil, nil, fmt.Errorf("failed to type check %v: %v", dir, strings.Join(typeErrors, "; "))
+	}
+	for _, name := range typesPkg.Scope().Names() {
+		typeName, ok := typesPkg.Scope().Lookup(name).(*types.TypeName)
+		if !ok || typeName.IsAlias() {
+			continue
+		}
+		if named, ok := typeName.Type().(*types.Named); ok && named.TypeParams().Len() > 0 {
+			result[name] = &genericType{Named: named, arguments: make([][]types.Type, named.TypeParams().Len())}
+		}
+	}
+	var instances = []types.Type{}
+	for _, instance := range info.Instances {
+		instances = append(instances, instance.Type)
+	}
+	addInstances(typesPkg, result, instances)
+	return typesPkg, result, nil
+}
+
+// generatedMethods are the methods of the gojay interfaces implemented by the generated code
+var generatedMethods = []string{"MarshalJSONObject", "UnmarshalJSONObject", "MarshalJSONArray", "UnmarshalJSONArray", "NKeys", "IsNil"}
+
+// isMissingGeneratedMethod returns true if a type check error is caused by a method the code being generated adds,
+// as the package may already use the generated code
+func isMissingGeneratedMethod(err error) bool {
+	for _, method := range generatedMethods {
+		if strings.Contains(err.Error(), "missing method "+method) || strings.Contains(err.Error(), "."+method+" undefined") {
+			return true
+		}
+	}
+	return false
+}
+
+// hasTypeParams returns true if pkg declares a generic type
+func hasTypeParams(pkg *ast.Package) bool {
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				if spec.(*ast.TypeSpec).TypeParams != nil {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// addInstances adds the type arguments of the instances of the generic types, and of the instances used by their fields,
+// i.e Page[int] for the Page *Page[T] field of Envelope[int, string]
+func addInstances(pkg *types.Package, generics map[string]*genericType, instances []types.Type) {
+	var visited = map[string]bool{}
+	var visit func(t types.Type)
+	visit = func(t types.Type) {
+		switch t := t.(type) {
+		case *types.Pointer:
+			visit(t.Elem())
+		case *types.Slice:
+			visit(t.Elem())
+		case *types.Array:
+			visit(t.Elem())
+		case *types.Map:
+			visit(t.Key())
+			visit(t.Elem())
+		case *types.Named:
+			generic := generics[t.Obj().Name()]
+			if generic == nil || t.Obj().Pkg() != pkg || t.TypeArgs().Len() == 0 || visited[t.String()] {
+				return
+			}
+			visited[t.String()] = true
+			for i := 0; i < t.TypeArgs().Len(); i++ {
+				// instances within a generic type are added with the instances of the generic type
+				if hasTypeParam(t.TypeArgs().At(i)) {
+					return
+				}
+			}
+			for i := 0; i < t.TypeArgs().Len(); i++ {
+				generic.addArgument(i, t.TypeArgs().At(i))
+				visit(t.TypeArgs().At(i))
+			}
+			if structType, ok := t.Underlying().(*types.Struct); ok {
+				for i := 0; i < structType.NumFields(); i++ {
+					visit(structType.Field(i).Type())
+				}
+			}
+		}
+	}
+	for _, instance := range instances {
+		visit(instance)
+	}
+	for _, generic := range generics {
+		for _, arguments := range generic.arguments {
+			sort.Slice(arguments, func(i, j int) bool {
+				return arguments[i].String() < arguments[j].String()
+			})
+		}
+	}
+}
+
+// hasTypeParam returns true if t depends on a type parameter, i.e T, []T or Page[T]
+func hasTypeParam(t types.Type) bool {
+	switch t := t.(type) {
+	case *types.TypeParam:
+		return true
+	case *types.Pointer:
+		return hasTypeParam(t.Elem())
+	case *types.Slice:
+		return hasTypeParam(t.Elem())
+	case *types.Array:
+		return hasTypeParam(t.Elem())
+	case *types.Map:
+		return hasTypeParam(t.Key()) || hasTypeParam(t.Elem())
+	case *types.Named:
+		for i := 0; i < t.TypeArgs().Len(); i++ {
+			if hasTypeParam(t.TypeArgs().At(i)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// typeArgs returns the type parameters of a generic type as used in a receiver, i.e [T, M], or an empty string
+func (g *Generator) typeArgs(typeName string) string {
+	generic := g.generics[typeName]
+	if generic == nil {
+		return ""
+	}
+	var params = []string{}
+	for i := 0; i < generic.TypeParams().Len(); i++ {
+		params = append(params, generic.TypeParams().At(i).Obj().Name())
+	}
+	return "[" + strings.Join(params, ", ") + "]"
+}
+
+// genericFieldType returns whether the type of a field of the struct depends on type parameters or is a generic type instance,
+// and the type parameter of the field values, i.e T for a T, *T, []T or []*T field
+func (s *Struct) genericFieldType(fieldName string) (isGeneric bool, typeParam string) {
+	if s.typesPkg == nil {
+		return false, ""
+	}
+	typeName, ok := s.typesPkg.Scope().Lookup(s.Name).(*types.TypeName)
+	if !ok {
+		return false, ""
+	}
+	field, _, _ := types.LookupFieldOrMethod(typeName.Type(), true, s.typesPkg, fieldName)
+	if field == nil {
+		return false, ""
+	}
+	fieldType := field.Type()
+	if pointer, ok := fieldType.(*types.Pointer); ok {
+		fieldType = pointer.Elem()
+	}
+	if slice, ok := fieldType.(*types.Slice); ok {
+		fieldType = slice.Elem()
+		if pointer, ok := fieldType.(*types.Pointer); ok {
+			fieldType = pointer.Elem()
+		}
+	}
+	switch t := fieldType.(type) {
+	case *types.TypeParam:
+		return true, t.Obj().Name()
+	case *types.Named:
+		if t.TypeArgs().Len() > 0 {
+			return true, ""
+		}
+	}
+	return isGenericType(field.Type()), ""
+}
+
+// isGenericType returns true if t depends on a type parameter or a generic type instance, i.e map[string]T or map[string]Page[Item]
+func isGenericType(t types.Type) bool {
+	switch t := t.(type) {
+	case *types.TypeParam:
+		return true
+	case *types.Pointer:
+		return isGenericType(t.Elem())
+	case *types.Slice:
+		return isGenericType(t.Elem())
+	case *types.Array:
+		return isGenericType(t.Elem())
+	case *types.Map:
+		return isGenericType(t.Key()) || isGenericType(t.Elem())
+	case *types.Named:
+		return t.TypeArgs().Len() > 0
+	}
+	return false
+}
+
+// generateGenericCode generates the code of the generic type of an instance, i.e Page for Page[Item],
+// the struct types used as type arguments are generated with the functions of the type parameters
+func (s *Struct) generateGenericCode(typeName string) error {
+	if index := strings.Index(typeName, "["); index > 0 {
+		typeName = typeName[:index]
+	}
+	return s.generateStructCode(typeName)
+}
+
+// typeArgument is a type argument of a type parameter, with the gojay methods encoding and decoding it
+type typeArgument struct {
+	Type      string //type argument, i.e Level
+	Method    string //gojay method, i.e Int for Encoder.IntKey and Decoder.Int
+	BuiltIn   string //builtin type of the gojay method, i.e int, or an empty string for an object
+	OmitEmpty string
+}
+
+// typeArgumentMethods are the gojay methods encoding and decoding the builtin types, uint is encoded as an uint64
+var typeArgumentMethods = map[string]string{
+	"string": "String", "bool": "Bool",
+	"int": "Int", "int8": "Int8", "int16": "Int16", "int32": "Int32", "int64": "Int64",
+	"uint": "Uint64", "uint8": "Uint8", "uint16": "Uint16", "uint32": "Uint32", "uint64": "Uint64",
+	"float32": "Float32", "float64": "Float64",
+}
+
+// Value returns the value passed to the encoding method, converted to its builtin type if needed
+func (a *typeArgument) Value() string {
+	if a.BuiltIn == "" {
+		return "value"
+	}
+	if a.BuiltIn == a.Type {
+		return "*value"
+	}
+	return a.BuiltIn + "(*value)"
+}
+
+// newTypeArgument returns the type argument of a type parameter of owner
+func (s *Struct) newTypeArgument(owner, param string, argument types.Type) (*typeArgument, error) {
+	var result = &typeArgument{Type: types.TypeString(argument, types.RelativeTo(s.typesPkg))}
+	if named, ok := argument.(*types.Named); ok && named.Obj().Pkg() != s.typesPkg {
+		return nil, fmt.Errorf("Unsupported type argument %v of type parameter %v of %v", result.Type, param, owner)
+	}
+	switch t := argument.Underlying().(type) {
+	case *types.Basic:
+		result.BuiltIn = t.Name()
+		if result.BuiltIn == "uint" {
+			result.BuiltIn = "uint64"
+		}
+		if result.Method = typeArgumentMethods[t.Name()]; result.Method != "" {
+			return result, nil
+		}
+	case *types.Struct:
+		if _, ok := argument.(*types.Named); ok {
+			result.Method = "Object"
+			return result, s.generateGenericCode(result.Type)
+		}
+	}
+	return nil, fmt.Errorf("Unsupported type argument %v of type parameter %v of %v", result.Type, param, owner)
+}
+
+// generateTypeParamCode generates the functions encoding and decoding the values of a type parameter of the struct,
+// with a case for each type argument of the type parameter
+func (s *Struct) generateTypeParamCode(field *Field) error {
+	generic := s.generics[s.Name]
+	var index = 0
+	for index < generic.TypeParams().Len() && generic.TypeParams().At(index).Obj().Name() != field.TypeParam {
+		index++
+	}
+	type helper struct {
+		key       string
+		template  int
+		omitEmpty string
+	}
+	var helpers = []helper{{"decode" + field.TypeParamHelper, typeParamDecode, ""}}
+	if field.IsSlice {
+		helpers = append(helpers, helper{"encode" + field.TypeParamHelper, typeParamEncode, ""})
+	} else {
+		helpers = append(helpers, helper{"encode" + field.TypeParamHelper + "Key" + field.OmitEmpty, typeParamEncodeKey, field.OmitEmpty})
+	}
+	for _, helper := range helpers {
+		if _, ok := s.typeParamTypes[helper.key]; ok {
+			continue
+		}
+		var arguments = []*typeArgument{}
+		for _, argument := range generic.arguments[index] {
+			typeArgument, err := s.newTypeArgument(s.Name, field.TypeParam, argument)
+			if err != nil {
+				return err
+			}
+			typeArgument.OmitEmpty = helper.omitEmpty
+			arguments = append(arguments, typeArgument)
+		}
+		code, err := expandBlockTemplate(helper.template, struct {
+			Name      string
+			Owner     string
+			TypeParam string
+			Arguments []*typeArgument
+		}{helper.key, s.Name, field.TypeParam, arguments})
+		if err != nil {
+			return err
+		}
+		s.typeParamTypes[helper.key] = code
+		s.helperFiles[helper.key] = s.file
+	}
+	s.addImport("fmt")
+	return nil
+}
@@ -52,6 +52,70 @@ func getSliceHelperTypeName(typeName string, isPointer bool) string {
 	return strings.Replace(pluralName, ".", "", -1)
 }
 
+//tagOption represents a key=value option of the gojay tag
+type tagOption struct {
+	Key   string
+	Value string
+}
+
+//getGojayTagOptions returns the options of the gojay tag, i.e gojay:"discriminator=type;Circle=circle;Square=square"
+func getGojayTagOptions(tag string) []*tagOption {
+	if tag == "" {
+		return nil
+	}
+	var structTag = reflect.StructTag(strings.Replace(tag, "`", "", len(tag)))
+	options, ok := structTag.Lookup("gojay")
+	if !ok {
+		return nil
+	}
+	var result = []*tagOption{}
+	for _, option := range strings.Split(options, ";") {
+		pair := strings.SplitN(option, "=", 2)
+		if len(pair) != 2 {
+			continue
+		}
+		result = append(result, &tagOption{Key: strings.TrimSpace(pair[0]), Value: strings.TrimSpace(pair[1])})
+	}
+	return result
+}
+
+//getGojayTagOption returns the value of the key option of the gojay tag
+func getGojayTagOption(tag, key string) string {
+	for _, option := range getGojayTagOptions(tag) {
+		if option.Key == key {
+			return option.Value
+		}
+	}
+	return ""
+}
+
+//getUnionTypes reads the discriminator key and the concrete types of a tagged union interface field,
+//i.e gojay:"discriminator=type;Circle=circle;Square=square"
+func getUnionTypes(tag string) (string, []*UnionType) {
+	var discriminator = getGojayTagOption(tag, "discriminator")
+	if discriminator == "" {
+		return "", nil
+	}
+	var unionTypes = []*UnionType{}
+	for _, option := range getGojayTagOptions(tag) {
+		if option.Key != "discriminator" {
+			unionTypes = append(unionTypes, &UnionType{Type: option.Key, Discriminator: option.Value})
+		}
+	}
+	return discriminator, unionTypes
+}
+
+// hasTagOption returns true if the tag has the option after the key, i.e string for json:"id,string"
+func hasTagOption(tag, tagName, option string) bool {
+	var options = getTagOptions(tag, tagName)
+	for i := 1; i < len(options); i++ {
+		if options[i] == option {
+			return true
+		}
+	}
+	return false
+}
+
 func isSkipable(options *Options, field *toolbox.FieldInfo) bool {
 	if options := getTagOptions(field.Tag, options.TagName); len(options) > 0 {
 		for _, candidate := range options {
@@ -76,13 +140,20 @@ func getPoolName(typeName string) string {
 }
 
 func getJSONKey(options *Options, field *toolbox.FieldInfo) string {
-	var key = field.Name
+	if key := getJSONTagName(options, field); key != "" {
+		return key
+	}
+	return field.Name
+}
+
+// getJSONTagName returns the key given by the field tag, or an empty string, i.e for json:",omitempty"
+func getJSONTagName(options *Options, field *toolbox.FieldInfo) string {
 	if field.Tag != "" {
 		if options := getTagOptions(field.Tag, options.TagName); len(options) > 0 {
-			key = options[0]
+			return options[0]
 		}
 	}
-	return key
+	return ""
 }
 
 func normalizeTypeName(typeName string) string {
@@ -0,0 +1,161 @@
+package codegen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/viant/toolbox"
+)
+
+// mapType represents a map helper type, i.e type StringIntMap map[string]int
+type mapType struct {
+	HelperType     string
+	RawType        string
+	KeyType        string
+	KeyParser      string //strconv parsing function for integer keys, empty for string keys
+	KeyBitSize     int
+	KeyDecode      string //converts the decoded key to the map key type
+	KeyEncode      string //converts the map key to a string
+	KeySort        string //sorts keys
+	ValueType      string
+	DecodedType    string //type of the decoded value
+	ValueInit      string //initialises value, empty to use the value type zero value
+	ValueStore     string //converts decoded value to the map value type
+	ValueEncode    string //converts map value to what the encoding method takes
+	DecodingCall   string
+	EncodingMethod string
+}
+
+func getMapHelperTypeName(keyType, valueType string) string {
+	var valueName string
+	if isCollectionType(valueType) {
+		valueName = elementHelperTypeName(valueType)
+	} else {
+		valueName = firstLetterToUppercase(normalizeTypeName(valueType))
+		if strings.HasPrefix(valueType, "*") {
+			valueName += "Ptr"
+		}
+	}
+	return strings.Replace(firstLetterToUppercase(keyType)+valueName+"Map", ".", "", -1)
+}
+
+// builtinMethodType returns the type of the Decoder and Encoder methods of a builtin type,
+// uint has no methods of its own and is decoded and encoded as an uint64.
+func builtinMethodType(typeName string) string {
+	if typeName == "uint" {
+		return "uint64"
+	}
+	return typeName
+}
+
+func isBaseType(typeName string) bool {
+	switch typeName {
+	case "string", "bool", "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		return true
+	}
+	return false
+}
+
+// generateMapType generates helper type code for a map field
+func (s *Struct) generateMapType(field *toolbox.FieldInfo) error {
+	var result = &mapType{
+		HelperType:  getMapHelperTypeName(field.KeyTypeName, field.ValueTypeName),
+		RawType:     field.TypeName,
+		KeyType:     field.KeyTypeName,
+		ValueType:   field.ValueTypeName,
+		DecodedType: field.ValueTypeName,
+		ValueStore:  "value",
+		ValueEncode: "value",
+		KeyDecode:   "k",
+		KeyEncode:   "k",
+		KeySort:     "sort.Strings(keys)",
+	}
+	if _, ok := s.mapTypes[result.RawType]; ok {
+		return nil
+	}
+	var keyType = result.KeyType
+	if baseType := s.baseType(keyType); baseType != "" {
+		keyType = baseType
+		result.KeyDecode = result.KeyType + "(k)"
+		result.KeyEncode = "string(k)"
+	}
+	switch keyType {
+	case "string":
+	case "int", "int8", "int16", "int32", "int64":
+		result.KeyParser = "ParseInt"
+		result.KeyEncode = "strconv.FormatInt(int64(k), 10)"
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		result.KeyParser = "ParseUint"
+		result.KeyEncode = "strconv.FormatUint(uint64(k), 10)"
+	default:
+		return fmt.Errorf("Unsupported map key type %s for field %s", result.KeyType, field.Name)
+	}
+	if result.KeyType != "string" {
+		// as encoding/json, keys are sorted by their string form, i.e "12" < "9"
+		result.KeySort = fmt.Sprintf("sort.Slice(keys, func(i, j int) bool { return %v < %v })",
+			strings.Replace(result.KeyEncode, "(k)", "(keys[i])", 1), strings.Replace(result.KeyEncode, "(k)", "(keys[j])", 1))
+	}
+	if result.KeyParser != "" {
+		result.KeyDecode = result.KeyType + "(key)"
+		//int and uint keys have no size suffix, bit size 0 stands for the platform size
+		result.KeyBitSize, _ = strconv.Atoi(strings.TrimPrefix(strings.TrimPrefix(keyType, "u"), "int"))
+		s.addImport("strconv")
+	}
+	valueType := normalizeTypeName(result.ValueType)
+	switch {
+	case isBaseType(result.ValueType):
+		methodType := builtinMethodType(valueType)
+		result.DecodingCall = firstLetterToUppercase(methodType) + "(&value)"
+		result.EncodingMethod = firstLetterToUppercase(methodType)
+		if methodType != valueType {
+			result.DecodedType = methodType
+			result.ValueStore = valueType + "(value)"
+			result.ValueEncode = methodType + "(value)"
+		}
+	case s.baseType(result.ValueType) != "":
+		methodType := builtinMethodType(s.baseType(result.ValueType))
+		result.DecodedType = methodType
+		result.DecodingCall = firstLetterToUppercase(methodType) + "(&value)"
+		result.EncodingMethod = firstLetterToUppercase(methodType)
+		result.ValueStore = result.ValueType + "(value)"
+		result.ValueEncode = methodType + "(value)"
+	case isCollectionType(result.ValueType):
+		// slices, arrays and maps share the helper types of composite fields, i.e map[string][][]int
+		helperType, err := s.generateCompositeType(field.Name, result.ValueType)
+		if err != nil {
+			return err
+		}
+		result.ValueInit = helperType + "{}"
+		result.ValueStore = result.ValueType + "(value)"
+		result.ValueEncode = helperType + "(value)"
+		result.DecodingCall = "Array(&value)"
+		result.EncodingMethod = "Array"
+		if strings.HasPrefix(result.ValueType, "map[") {
+			result.DecodingCall = "Object(value)"
+			result.EncodingMethod = "Object"
+		}
+	case s.Type(valueType) != nil:
+		if err := s.generateStructCode(valueType); err != nil {
+			return err
+		}
+		result.EncodingMethod = "Object"
+		if strings.HasPrefix(result.ValueType, "*") {
+			result.ValueInit = "&" + valueType + "{}"
+			result.DecodingCall = "Object(value)"
+		} else {
+			result.DecodingCall = "Object(&value)"
+			result.ValueEncode = "&value"
+		}
+	default:
+		return fmt.Errorf("Unsupported map value type %s for field %s", result.ValueType, field.Name)
+	}
+	s.addImport("sort")
+	code, err := expandBlockTemplate(mapTypeCode, result)
+	if err != nil {
+		return err
+	}
+	s.mapTypes[result.RawType] = code
+	s.helperFiles[result.RawType] = s.file
+	return nil
+}
@@ -16,15 +16,14 @@ type Options struct {
 	PoolObjects bool
 	TagName     string
 	Pkg         string
+	Check       bool
+	Tests       bool
 }
 
 func (o *Options) Validate() error {
 	if o.Source == "" {
 		return errors.New("Source was empty")
 	}
-	if len(o.Types) == 0 {
-		return errors.New("Types was empty")
-	}
 	return nil
 }
 
@@ -35,6 +34,8 @@ const (
 	optionKeyTagName     = "a"
 	optionKeyPoolObjects = "p"
 	optionKeyPkg         = "pkg"
+	optionKeyCheck       = "check"
+	optionKeyTests       = "tests"
 )
 
 //NewOptionsWithFlagSet creates a new options for the supplide flagset
@@ -46,8 +47,12 @@ func NewOptionsWithFlagSet(set *flag.FlagSet) *Options {
 	result.Source = set.Lookup(optionKeySource).Value.String()
 	result.PoolObjects = toolbox.AsBoolean(set.Lookup(optionKeyPoolObjects).Value.String())
 	result.TagName = set.Lookup(optionKeyTagName).Value.String()
-	result.Types = strings.Split(set.Lookup(optionKeyTypes).Value.String(), ",")
+	if types := set.Lookup(optionKeyTypes).Value.String(); types != "" {
+		result.Types = strings.Split(types, ",")
+	}
 	result.Pkg = set.Lookup(optionKeyPkg).Value.String()
+	result.Check = toolbox.AsBoolean(set.Lookup(optionKeyCheck).Value.String())
+	result.Tests = toolbox.AsBoolean(set.Lookup(optionKeyTests).Value.String())
 	if result.Source == "" {
 		result.Source = url.NewResource(".").ParsedURL.Path
 	}
@@ -0,0 +1,68 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/viant/toolbox"
+)
+
+const (
+	fieldSetType   = "gojay.FieldSet"
+	maxFieldSetLen = 64
+)
+
+// presenceField represents a JSON field recorded in the field set of a struct
+type presenceField struct {
+	Name  string
+	Key   string
+	Index int
+}
+
+// fieldSetAccessor returns the selector of the gojay.FieldSet field of a struct recording the decoded fields, i.e m.fields, or an empty string
+func (s *Struct) fieldSetAccessor(typeInfo *toolbox.TypeInfo) string {
+	for _, field := range typeInfo.Fields() {
+		if field.TypeName == fieldSetType && !field.IsAnonymous {
+			return s.Alias + "." + field.Name
+		}
+	}
+	return ""
+}
+
+// generatePresence records each decoded field in the field set, a key decoded again is reported to the decoder
+// so that duplicate keys do not stop the decoding before NKeys keys are decoded, it returns the presence methods
+func (s *Struct) generatePresence(accessor string, fields []*jsonField, decodingCases []string) (string, error) {
+	if len(fields) > maxFieldSetLen {
+		return "", fmt.Errorf("%v has %v JSON fields, %v can record up to %v fields", s.Name, len(fields), fieldSetType, maxFieldSetLen)
+	}
+	if len(decodingCases) != len(fields) {
+		return "", fmt.Errorf("%v has %v decoded fields for %v JSON fields", s.Name, len(decodingCases), len(fields))
+	}
+	var names = map[string]bool{}
+	var presenceFields = []*presenceField{}
+	for i, field := range fields {
+		if names[field.Name] {
+			return "", fmt.Errorf("%v has several JSON fields named %v, presence methods can not be generated", s.Name, field.Name)
+		}
+		names[field.Name] = true
+		code, err := expandFieldTemplate(decodePresence, struct {
+			Accessor string
+			Index    int
+		}{accessor, i})
+		if err != nil {
+			return "", err
+		}
+		// the presence code goes right after the case line
+		decodingCases[i] = strings.Replace(decodingCases[i], "\n", "\n"+code, 1)
+		presenceFields = append(presenceFields, &presenceField{Name: field.Name, Key: field.Key, Index: i})
+	}
+	return expandBlockTemplate(presenceMethods, struct {
+		Receiver string
+		Accessor string
+		Fields   []*presenceField
+	}{
+		Receiver: s.Alias + " *" + s.Name + s.TypeArgs,
+		Accessor: accessor,
+		Fields:   presenceFields,
+	})
+}
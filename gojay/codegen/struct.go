@@ -11,9 +11,10 @@ type Struct struct {
 	*toolbox.TypeInfo
 	referenced *toolbox.TypeInfo
 	*Generator
-	Alias string
-	Init  string
-	Body  string
+	Alias    string
+	TypeArgs string //type parameters of a generic struct, i.e [T]
+	Init     string
+	Body     string
 }
 
 //Generate generates decoderCode + structRelease + encoderCode
@@ -22,17 +23,34 @@ func (s *Struct) Generate() (string, error) {
 }
 
 func (s *Struct) generateEncoding(structInfo *toolbox.TypeInfo) (string, error) {
-	var initEmbedded, decodingCases, err = s.generateFieldDecoding(structInfo.Fields())
+	fields, err := s.jsonFields(structInfo)
+	if err != nil {
+		return "", err
+	}
+	initEmbedded, err := s.generateEmbeddedInit(fields)
+	if err != nil {
+		return "", err
+	}
+	decodingCases, err := s.generateFieldDecoding(fields)
 	if err != nil {
 		return "", err
 	}
 
-	encodingCases, err := s.generateFieldEncoding(structInfo.Fields())
+	encodingCases, err := s.generateFieldEncoding(fields)
 	if err != nil {
 		return "", err
 	}
+	var presence = ""
+	var fieldCount = len(decodingCases)
+	if accessor := s.fieldSetAccessor(structInfo); accessor != "" {
+		if presence, err = s.generatePresence(accessor, fields, decodingCases); err != nil {
+			return "", err
+		}
+		// a struct tracking its fields decodes all keys, so that every key present and duplicate is recorded
+		fieldCount = 0
+	}
 	var resetCode = ""
-	if s.options.PoolObjects {
+	if s.options.PoolObjects && s.TypeArgs == "" {
 		resetCode, err = s.generateReset(structInfo.Fields())
 		if err != nil {
 			return "", err
@@ -45,14 +63,16 @@ func (s *Struct) generateEncoding(structInfo *toolbox.TypeInfo) (string, error)
 		EncodingCases string
 		DecodingCases string
 		Reset         string
+		Presence      string
 		FieldCount    int
 	}{
-		Receiver:      s.Alias + " *" + s.Name,
+		Receiver:      s.Alias + " *" + s.Name + s.TypeArgs,
 		DecodingCases: strings.Join(decodingCases, "\n"),
 		EncodingCases: strings.Join(encodingCases, "\n"),
-		FieldCount:    len(decodingCases),
+		FieldCount:    fieldCount,
 		InitEmbedded:  initEmbedded,
 		Reset:         resetCode,
+		Presence:      presence,
 		Alias:         s.Alias,
 	}
 	return expandBlockTemplate(encodingStructType, data)
@@ -81,7 +101,10 @@ func (s *Struct) generateFieldReset(fields []*toolbox.FieldInfo) ([]string, erro
 		if err != nil {
 			return nil, err
 		}
-		if field.IsPointer || field.IsSlice || (fieldTypeInfo != nil && fieldTypeInfo.IsSlice) {
+		if field.RawType == fieldSetType {
+			field.Reset = "0"
+			templateKey = resetFieldValue
+		} else if field.IsPointer || field.IsSlice || field.IsMap || field.Discriminator != "" || field.BaseType != "" || (fieldTypeInfo != nil && fieldTypeInfo.IsSlice) {
 			templateKey = resetFieldValue
 		} else {
 			switch field.Type {
@@ -100,42 +123,29 @@ func (s *Struct) generateFieldReset(fields []*toolbox.FieldInfo) ([]string, erro
 	return fieldReset, nil
 }
 
-func (s *Struct) generateFieldDecoding(fields []*toolbox.FieldInfo) (string, []string, error) {
+func (s *Struct) generateFieldDecoding(fields []*jsonField) ([]string, error) {
 
 	fieldCases := []string{}
-	var initCode = ""
 	for i := range fields {
-		if isSkipable(s.options, fields[i]) {
-			continue
-		}
 		var templateKey = -1
-		fieldTypeInfo := s.Type(normalizeTypeName(fields[i].TypeName))
-		field, err := NewField(s, fields[i], fieldTypeInfo)
+		field, fieldTypeInfo, err := s.newJSONField(fields[i])
 		if err != nil {
-			return "", nil, err
+			return nil, err
 		}
 		if fieldTypeInfo != nil {
 			if err = s.generateStructCode(fieldTypeInfo.Name); err != nil {
-				return "", nil, err
+				return nil, err
 			}
 		}
 
-		if field.IsAnonymous {
-			if fieldTypeInfo != nil {
-				if field.IsPointer {
-					init, err := expandBlockTemplate(embeddedStructInit, field)
-					if err != nil {
-						return "", nil, err
-					}
-					initCode += init
-				}
-				init, embeddedCases, err := s.generateFieldDecoding(fieldTypeInfo.Fields())
-				if err != nil {
-					return "", nil, err
-				}
-				initCode += init
-				fieldCases = append(fieldCases, embeddedCases...)
+		if templateKey, err = s.customFieldDecoding(fields[i].FieldInfo, field); err != nil {
+			return nil, err
+		} else if templateKey != -1 {
+			decodingCase, err := expandFieldTemplate(templateKey, field)
+			if err != nil {
+				return nil, err
 			}
+			fieldCases = append(fieldCases, decodingCase)
 			continue
 		}
 
@@ -170,12 +180,12 @@ func (s *Struct) generateFieldDecoding(fields []*toolbox.FieldInfo) (string, []s
 				}
 
 				if err = s.generateStructCode(field.ComponentType); err != nil {
-					return "", nil, err
+					return nil, err
 				}
 
 				templateKey = decodeStructSlice
 				if err = s.generateObjectArray(field); err != nil {
-					return "", nil, err
+					return nil, err
 				}
 
 				break main
@@ -183,71 +193,58 @@ func (s *Struct) generateFieldDecoding(fields []*toolbox.FieldInfo) (string, []s
 				if f, _, ok := s.typedFieldDecode(field, field.ComponentType); ok {
 					templateKey = decodeStructSlice
 					if err = f(field); err != nil {
-						return "", nil, err
+						return nil, err
 					}
 				} else {
 					templateKey = decodeStructSlice
 					if err = s.generateObjectArray(field); err != nil {
-						return "", nil, err
+						return nil, err
 					}
 				}
 			} else if _, k, ok := s.typedFieldDecode(field, field.Type); ok {
 				templateKey = k
 			} else {
 				// templateKey = decodeUnknown
-				return "", nil, fmt.Errorf("Unknown type %s for field %s", field.Type, field.Name)
+				return nil, fmt.Errorf("Unknown type %s for field %s", field.Type, field.Name)
 			}
 		}
 		if templateKey != -1 {
 			decodingCase, err := expandFieldTemplate(templateKey, field)
 			if err != nil {
-				return "", nil, err
+				return nil, err
 			}
 			fieldCases = append(fieldCases, decodingCase)
 		}
 
 	}
-	return initCode, fieldCases, nil
-}
-
-func (s *Struct) generateEmbeddedFieldEncoding(field *Field, fieldTypeInfo *toolbox.TypeInfo) ([]string, error) {
-	var result = []string{}
-	if fieldTypeInfo != nil {
-		embeddedCases, err := s.generateFieldEncoding(fieldTypeInfo.Fields())
-		if err != nil {
-			return nil, err
-		}
-		if field.IsPointer {
-			result = append(result, fmt.Sprintf("    if %v != nil {", field.Accessor))
-			for _, code := range embeddedCases {
-				result = append(result, "    "+code)
-			}
-			result = append(result, "    }")
-		} else {
-			result = append(result, embeddedCases...)
-		}
-	}
-	return result, nil
+	return fieldCases, nil
 }
 
-func (s *Struct) generateFieldEncoding(fields []*toolbox.FieldInfo) ([]string, error) {
+func (s *Struct) generateFieldEncoding(fields []*jsonField) ([]string, error) {
 	fieldCases := []string{}
+	// promoted fields are only encoded if the embedded pointers they are promoted through are not nil
+	var pointers = []string{}
 	for i := range fields {
-		if isSkipable(s.options, fields[i]) {
-			continue
-		}
 		var templateKey = -1
-		fieldTypeInfo := s.Type(normalizeTypeName(fields[i].TypeName))
-		field, err := NewField(s, fields[i], fieldTypeInfo)
+		field, fieldTypeInfo, err := s.newJSONField(fields[i])
 		if err != nil {
 			return nil, err
 		}
-		if field.IsAnonymous {
-			embedded, err := s.generateEmbeddedFieldEncoding(field, fieldTypeInfo)
+		if path := fields[i].pointerPath(s.Alias); strings.Join(path, ",") != strings.Join(pointers, ",") {
+			if len(pointers) > 0 {
+				fieldCases = append(fieldCases, "    }")
+			}
+			if pointers = path; len(pointers) > 0 {
+				fieldCases = append(fieldCases, fmt.Sprintf("    if %v != nil {", strings.Join(pointers, " != nil && ")))
+			}
+		}
+		if templateKey = s.customFieldEncoding(field); templateKey != -1 {
+			s.trackEncodingJSON(templateKey, field)
+			encodingCase, err := expandFieldTemplate(templateKey, field)
 			if err != nil {
 				return nil, err
 			}
-			fieldCases = append(fieldCases, embedded...)
+			fieldCases = append(fieldCases, encodingCase)
 			continue
 		}
 	main:
@@ -285,6 +282,7 @@ func (s *Struct) generateFieldEncoding(fields []*toolbox.FieldInfo) ([]string, e
 			}
 		}
 		if templateKey != -1 {
+			s.trackEncodingJSON(templateKey, field)
 			decodingCase, err := expandFieldTemplate(templateKey, field)
 			if err != nil {
 				return nil, err
@@ -293,9 +291,93 @@ func (s *Struct) generateFieldEncoding(fields []*toolbox.FieldInfo) ([]string, e
 		}
 
 	}
+	if len(pointers) > 0 {
+		fieldCases = append(fieldCases, "    }")
+	}
 	return fieldCases, nil
 }
 
+//customFieldDecoding returns the decoding template of fields which are not decoded according to their type only, -1 otherwise
+func (s *Struct) customFieldDecoding(fieldInfo *toolbox.FieldInfo, field *Field) (int, error) {
+	switch {
+	case field.Codec != "":
+		return decodeCodec, s.addCodecImport(field.Codec)
+	case field.CompositeType != "":
+		_, err := s.generateCompositeType(field.Name, field.CompositeType)
+		return decodeComposite, err
+	case field.IsGeneric && field.IsMap:
+		return -1, fmt.Errorf("Unsupported generic map type %s for field %s", field.RawType, field.Name)
+	case field.IsGeneric && field.TypeParam != "":
+		s.genericTypes[s.Name] = true
+		if field.IsSlice {
+			return decodeGenericSlice, s.generateTypeParamCode(field)
+		}
+		return decodeGeneric, s.generateTypeParamCode(field)
+	case field.IsGeneric:
+		s.genericTypes[s.Name] = true
+		if field.IsSlice {
+			return decodeGenericSlice, s.generateGenericCode(field.ComponentType)
+		}
+		return decodeGeneric, s.generateGenericCode(field.Type)
+	case field.IsMap:
+		return decodeMap, s.generateMapType(fieldInfo)
+	case field.Discriminator != "":
+		for _, unionType := range field.UnionTypes {
+			if err := s.generateStructCode(unionType.Type); err != nil {
+				return -1, err
+			}
+		}
+		s.addImport("fmt")
+		return decodeUnion, nil
+	case field.Enum == enumText:
+		return decodeEnumText, nil
+	case field.Enum == enumString:
+		if field.ParseFunc == "" {
+			return -1, fmt.Errorf("Missing parse function for enum field %s", field.Name)
+		}
+		return decodeEnumString, nil
+	case field.AsString:
+		s.addImport("strconv")
+		field.ParseCall, _, _ = stringCodec(field.builtinType(), "")
+		return decodeAsString, nil
+	case field.BaseType != "" && field.IsSlice:
+		return decodeBaseTypeSlice, s.generateNamedTypeArray(field)
+	case field.BaseType != "":
+		return decodeNamedType, nil
+	}
+	return -1, nil
+}
+
+//customFieldEncoding returns the encoding template of fields which are not encoded according to their type only, -1 otherwise
+func (s *Struct) customFieldEncoding(field *Field) int {
+	switch {
+	case field.Codec != "":
+		return encodeCodec
+	case field.CompositeType != "":
+		return encodeComposite
+	case field.IsGeneric && field.IsSlice:
+		return encodeGenericSlice
+	case field.IsGeneric:
+		return encodeGeneric
+	case field.IsMap:
+		return encodeMap
+	case field.Discriminator != "":
+		return encodeUnion
+	case field.Enum == enumText:
+		return encodeEnumText
+	case field.Enum == enumString:
+		return encodeEnumString
+	case field.AsString:
+		_, field.FormatCall, _ = stringCodec(field.builtinType(), field.DereferenceModifier+field.Accessor)
+		return encodeAsString
+	case field.BaseType != "" && field.IsSlice:
+		return encodeBaseTypeSlice
+	case field.BaseType != "":
+		return encodeNamedType
+	}
+	return -1
+}
+
 var sqlNullTypes = []string{
 	"Bool",
 	"Float64",
@@ -341,5 +423,6 @@ func NewStruct(info *toolbox.TypeInfo, generator *Generator) *Struct {
 		TypeInfo:  info,
 		Generator: generator,
 		Alias:     extractReceiverAlias(info.Name),
+		TypeArgs:  generator.typeArgs(info.Name),
 	}
 }
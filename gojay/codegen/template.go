@@ -24,8 +24,33 @@ const (
 	decodeSQLNull
 	encodeSQLNull
 
+	decodeMap
+	encodeMap
+
+	decodeUnion
+	encodeUnion
+
+	decodeNamedType
+	encodeNamedType
+	decodeEnumText
+	encodeEnumText
+	decodeEnumString
+	encodeEnumString
+
+	decodeAsString
+	encodeAsString
+	decodeCodec
+	encodeCodec
+
 	decodeUnknown
 	encodeUnknown
+	decodeGeneric
+	encodeGeneric
+	decodeGenericSlice
+	encodeGenericSlice
+	decodeComposite
+	encodeComposite
+	decodePresence
 
 	resetFieldValue
 	poolInstanceRelease
@@ -115,12 +140,201 @@ var fieldTemplate = map[int]string{
 	encodeSQLNull: `{{if .IsPointer}}    if {{.Accessor}} != nil {
         enc.SQLNull{{.NullType}}Key{{.OmitEmpty}}("{{.Key}}", {{.PointerModifier}}{{.Accessor}})
     }{{else}}    enc.SQLNull{{.NullType}}Key{{.OmitEmpty}}("{{.Key}}", {{.PointerModifier}}{{.Accessor}}){{end}}`,
+	decodeMap: `		case "{{.Key}}":
+			if dec.Peek() == gojay.NullKind {
+				{{.Mutator}} = nil
+				return dec.Skip()
+			}
+			var aMap = {{.HelperType}}{}
+			err := dec.Object(aMap)
+			if err == nil {
+				{{.Mutator}} = {{.RawType}}(aMap)
+			}
+			return err
+`,
+	encodeMap: `{{if .OmitEmpty}}    enc.ObjectKey{{.OmitEmpty}}("{{.Key}}", {{.HelperType}}({{.Accessor}})){{else}}    if {{.Accessor}} == nil {
+        enc.NullKey("{{.Key}}")
+    } else {
+        enc.ObjectKey("{{.Key}}", {{.HelperType}}({{.Accessor}}))
+    }{{end}}`,
+	decodeUnion: `		case "{{.Key}}":
+			if dec.Peek() == gojay.NullKind {
+				{{.Mutator}} = nil
+				return dec.Skip()
+			}
+			var value = gojay.EmbeddedJSON{}
+			if err := dec.AddEmbeddedJSON(&value); err != nil {
+				return err
+			}
+			discriminator, err := gojay.GetString(value, "{{.DiscriminatorPointer}}")
+			if err != nil {
+				return err
+			}
+			switch discriminator {
+{{range .UnionTypes}}			case "{{.Discriminator}}":
+				var item = &{{.Type}}{}
+				if err = gojay.UnmarshalJSONObject(value, item); err == nil {
+					{{$.Mutator}} = item
+				}
+				return err
+{{end}}			}
+			return fmt.Errorf("unknown {{.Key}} {{.Discriminator}}: %q", discriminator)
+`,
+	encodeUnion: `    switch value := {{.Accessor}}.(type) {
+{{range .UnionTypes}}    case *{{.Type}}:
+        enc.ObjectKey("{{$.Key}}", gojay.EncodeObjectFunc(func(enc *gojay.Encoder) {
+            enc.StringKey("{{$.Discriminator}}", "{{.Discriminator}}")
+            value.MarshalJSONObject(enc)
+        }))
+{{end}}    case nil:
+{{if ne .OmitEmpty "OmitEmpty"}}        enc.NullKey("{{.Key}}")
+{{end}}    default:
+        enc.SetError(fmt.Errorf("unsupported {{.Key}} type %T", value))
+    }`,
+	decodeNamedType: `		case "{{.Key}}":
+			var value {{.BaseType}}
+			err := dec.{{.DecodingMethod}}(&value)
+			if err == nil {
+{{if .IsPointer}}				var named = {{.Type}}(value)
+				{{.Mutator}} = &named
+{{else}}				{{.Mutator}} = {{.Type}}(value)
+{{end}}			}
+			return err
+`,
+	encodeNamedType: `{{if .IsPointer}}    if {{.Accessor}} != nil {
+        enc.{{.EncodingMethod}}Key{{.OmitEmpty}}("{{.Key}}", {{.BaseType}}(*{{.Accessor}}))
+    }{{else}}    enc.{{.EncodingMethod}}Key{{.OmitEmpty}}("{{.Key}}", {{.BaseType}}({{.Accessor}})){{end}}`,
+	decodeEnumText: `		case "{{.Key}}":
+			var text string
+			if err := dec.String(&text); err != nil {
+				return err
+			}
+			var value {{.Type}}
+			err := value.UnmarshalText([]byte(text))
+			if err == nil {
+				{{.Mutator}} = {{if .IsPointer}}&{{end}}value
+			}
+			return err
+`,
+	encodeEnumText: `{{if .IsPointer}}    if {{.Accessor}} != nil {
+        if text, err := {{.Accessor}}.MarshalText(); err != nil {
+            enc.SetError(err)
+        } else {
+            enc.StringKey{{.OmitEmpty}}("{{.Key}}", string(text))
+        }
+    }{{else}}    if text, err := {{.Accessor}}.MarshalText(); err != nil {
+        enc.SetError(err)
+    } else {
+        enc.StringKey{{.OmitEmpty}}("{{.Key}}", string(text))
+    }{{end}}`,
+	decodeEnumString: `		case "{{.Key}}":
+			var text string
+			if err := dec.String(&text); err != nil {
+				return err
+			}
+			value, err := {{.ParseFunc}}(text)
+			if err == nil {
+				{{.Mutator}} = {{if .IsPointer}}&{{end}}value
+			}
+			return err
+`,
+	encodeEnumString: `{{if .IsPointer}}    if {{.Accessor}} != nil {
+        enc.StringKey{{.OmitEmpty}}("{{.Key}}", {{.Accessor}}.String())
+    }{{else}}    enc.StringKey{{.OmitEmpty}}("{{.Key}}", {{.Accessor}}.String()){{end}}`,
+	decodeAsString: `		case "{{.Key}}":
+			if dec.Peek() == gojay.NullKind {
+{{if .IsPointer}}				{{.Mutator}} = nil
+{{end}}				return dec.Skip()
+			}
+			var text string
+			if err := dec.String(&text); err != nil {
+				return err
+			}
+			parsed, err := {{.ParseCall}}
+			if err == nil {
+				var value = {{.Type}}(parsed)
+				{{.Mutator}} = {{if .IsPointer}}&{{end}}value
+			}
+			return err
+`,
+	encodeAsString: `{{if .IsPointer}}    if {{.Accessor}} != nil {
+        enc.StringKey("{{.Key}}", {{.FormatCall}})
+    }{{if ne .OmitEmpty "OmitEmpty"}} else {
+        enc.NullKey("{{.Key}}")
+    }{{end}}{{else if eq .OmitEmpty "OmitEmpty"}}    if {{.Accessor}} != {{.Reset}} {
+        enc.StringKey("{{.Key}}", {{.FormatCall}})
+    }{{else}}    enc.StringKey("{{.Key}}", {{.FormatCall}}){{end}}`,
+	decodeCodec: `		case "{{.Key}}":
+			return {{.DecodeFunc}}(dec, &{{.Mutator}})
+`,
+	encodeCodec: `    {{.EncodeFunc}}(enc, "{{.Key}}", {{.Accessor}})`,
 	decodeUnknown: `		case "{{.Key}}":
 			return dec.Any({{.PointerModifier}}{{.Accessor}})
 `,
 	encodeUnknown: `{{if .IsPointer}}    if {{.Accessor}} != nil {	
 		enc.Any({{.Accessor}})
 	}{{else}}enc.Any({{.Accessor}}){{end}}`,
+	decodeGeneric: `		case "{{.Key}}":{{if .IsPointer}}
+			if dec.Peek() == gojay.NullKind {
+				{{.Mutator}} = nil
+				return dec.Skip()
+			}
+			var value {{.Type}}
+			err := {{if .TypeParamHelper}}decode{{.TypeParamHelper}}(dec, &value){{else}}dec.Object(&value){{end}}
+			if err == nil {
+				{{.Mutator}} = &value
+			}
+			return err
+{{else}}
+			return {{if .TypeParamHelper}}decode{{.TypeParamHelper}}(dec, &{{.Mutator}}){{else}}dec.Object(&{{.Mutator}}){{end}}
+{{end}}`,
+	encodeGeneric: `{{if .IsPointer}}    if {{.Accessor}} != nil {
+        {{if .TypeParamHelper}}encode{{.TypeParamHelper}}Key{{.OmitEmpty}}(enc, "{{.Key}}", {{.Accessor}}){{else}}enc.ObjectKey("{{.Key}}", {{.Accessor}}){{end}}
+    }{{if ne .OmitEmpty "OmitEmpty"}} else {
+        enc.NullKey("{{.Key}}")
+    }{{end}}{{else}}    {{if .TypeParamHelper}}encode{{.TypeParamHelper}}Key{{.OmitEmpty}}(enc, "{{.Key}}", &{{.Accessor}}){{else}}enc.ObjectKey{{.OmitEmpty}}("{{.Key}}", &{{.Accessor}}){{end}}{{end}}`,
+	decodeGenericSlice: `		case "{{.Key}}":
+			return dec.Array(gojay.DecodeArrayFunc(func(dec *gojay.Decoder) error {
+				var value {{.ComponentType}}
+				if err := {{if .TypeParamHelper}}decode{{.TypeParamHelper}}(dec, &value){{else}}dec.Object(&value){{end}}; err != nil {
+					return err
+				}
+				{{.Mutator}} = append({{.Mutator}}, {{.ComponentInitModifier}}value)
+				return nil
+			}))
+`,
+	encodeGenericSlice: `{{if eq .OmitEmpty "OmitEmpty"}}    if len({{.Accessor}}) > 0 {
+{{end}}    enc.ArrayKey("{{.Key}}", gojay.EncodeArrayFunc(func(enc *gojay.Encoder) {
+        for i := range {{.Accessor}} {
+            {{if .TypeParamHelper}}encode{{.TypeParamHelper}}(enc, {{.ComponentPointerModifier}}{{.Accessor}}[i]){{else}}enc.Object({{.ComponentPointerModifier}}{{.Accessor}}[i]){{end}}
+        }
+    })){{if eq .OmitEmpty "OmitEmpty"}}
+    }{{end}}`,
+	decodeComposite: `		case "{{.Key}}":{{if .IsPointer}}
+			if dec.Peek() == gojay.NullKind {
+				{{.Mutator}} = nil
+				return dec.Skip()
+			}{{end}}
+			var value = {{.HelperType}}{}
+			err := dec.Array(&value)
+{{if .IsPointer}}			if err == nil {
+				{{.Mutator}} = (*{{.CompositeType}})(&value)
+			}
+{{else}}			if err == nil && len(value) > 0 {
+				{{.Mutator}} = {{.CompositeType}}(value)
+			}
+{{end}}			return err
+`,
+	encodeComposite: `{{if .IsPointer}}    if {{.Accessor}} != nil {
+        enc.ArrayKey("{{.Key}}", {{.HelperType}}(*{{.Accessor}}))
+    }{{if ne .OmitEmpty "OmitEmpty"}} else {
+        enc.NullKey("{{.Key}}")
+    }{{end}}{{else}}    enc.ArrayKey{{.OmitEmpty}}("{{.Key}}", {{.HelperType}}({{.Accessor}})){{end}}`,
+	decodePresence: `			if {{.Accessor}}.Has({{.Index}}) {
+				defer dec.DuplicateKey()
+			}
+			{{.Accessor}}.Set({{.Index}})
+`,
 	resetFieldValue: `{{if .ResetDependency}}{{.ResetDependency}}
 {{end}}    {{.Mutator}} = {{.Reset}}`,
 	poolInstanceRelease: `	{{.PoolName}}.Put({{.Accessor}})`,
@@ -142,11 +356,22 @@ const (
 	embeddedStructInit
 	timeSlice
 	typeSlice
+	mapTypeCode
+	namedTypeSlice
+	embeddedKeysInit
+	testFileCode
+	typeParamEncodeKey
+	typeParamEncode
+	typeParamDecode
+	compositeTypeCode
+	presenceMethods
 )
 
 var blockTemplate = map[int]string{
 	fileCode: `// Code generated by Gojay. DO NOT EDIT.
-
+{{if .BuildTag}}
+{{.BuildTag}}
+{{end}}
 
 package {{.Pkg}}
 
@@ -179,9 +404,9 @@ func ({{.Receiver}}) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 	return nil
 }
 
-// NKeys returns the number of keys to unmarshal
+// NKeys returns the number of keys to unmarshal{{if not .FieldCount}}, 0 decodes all keys{{end}}
 func ({{.Receiver}}) NKeys() int { return {{.FieldCount}} }
-
+{{.Presence}}
 {{.Reset}}
 
 `,
@@ -256,6 +481,33 @@ func (s {{.HelperType}})  MarshalJSONArray(enc *gojay.Encoder) {
 func (s {{.HelperType}})  IsNil() bool {
 	return len(s) == 0
 }
+`,
+	namedTypeSlice: `
+
+type {{.HelperType}} {{.RawType}}
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *{{.HelperType}}) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value {{.BaseType}}
+	if err := dec.{{.DecodingMethod}}(&value); err != nil {
+		return err
+	}
+	var named = {{.ComponentType}}(value)
+	*a = append(*a, {{.ComponentInitModifier}}named)
+	return nil
+}
+
+// MarshalJSONArray encodes arrays into JSON
+func (a {{.HelperType}}) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.{{.EncodingMethod}}({{.BaseType}}({{.ComponentDereferenceModifier}}item))
+	}
+}
+
+// IsNil checks if array is nil
+func (a {{.HelperType}}) IsNil() bool {
+	return len(a) == 0
+}
 `,
 	timeSlice: `
 type {{.HelperType}} {{.RawType}}
@@ -279,6 +531,101 @@ func (s {{.HelperType}})  IsNil() bool {
 	return len(s) == 0
 }
 `,
+	mapTypeCode: `
+type {{.HelperType}} {{.RawType}}
+
+// UnmarshalJSONObject decodes JSON object members into map
+func (m {{.HelperType}}) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	{{if .ValueInit}}var value = {{.ValueInit}}{{else}}var value {{.DecodedType}}{{end}}
+	if err := dec.{{.DecodingCall}}; err != nil {
+		return err
+	}
+{{if .KeyParser}}	key, err := strconv.{{.KeyParser}}(k, 10, {{.KeyBitSize}})
+	if err != nil {
+		return err
+	}
+{{end}}	m[{{.KeyDecode}}] = {{.ValueStore}}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal, 0 decodes all keys
+func (m {{.HelperType}}) NKeys() int {
+	return 0
+}
+
+// MarshalJSONObject encodes map into JSON, keys are sorted to get a deterministic output
+func (m {{.HelperType}}) MarshalJSONObject(enc *gojay.Encoder) {
+	var keys = make([]{{.KeyType}}, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	{{.KeySort}}
+	for _, k := range keys {
+		var value = m[k]
+		enc.{{.EncodingMethod}}Key({{.KeyEncode}}, {{.ValueEncode}})
+	}
+}
+
+// IsNil checks if map is empty
+func (m {{.HelperType}}) IsNil() bool {
+	return len(m) == 0
+}
+`,
+	compositeTypeCode: `
+type {{.HelperType}} {{.RawType}}
+
+// UnmarshalJSONArray decodes JSON array elements into {{if .IsArray}}array, elements beyond the array length are skipped{{else}}slice{{end}}
+func (a *{{.HelperType}}) UnmarshalJSONArray(dec *gojay.Decoder) error {
+{{if .IsArray}}	var index = dec.Index()
+	if index >= len(a) {
+		return dec.Skip()
+	}
+{{end}}{{if .IsPointer}}	if dec.Peek() == gojay.NullKind {
+{{if .IsArray}}		a[index] = nil
+{{else}}		*a = append(*a, nil)
+{{end}}		return dec.Skip()
+	}
+{{end}}	{{if .ValueInit}}var value = {{.ValueInit}}{{else}}var value {{.DecodedType}}{{end}}
+	if err := dec.{{.DecodingCall}}; err != nil {
+		return err
+	}
+	var item = {{.ValueStore}}
+{{if .IsArray}}	a[index] = {{if .IsPointer}}&{{end}}item
+{{else}}	*a = append(*a, {{if .IsPointer}}&{{end}}item)
+{{end}}	return nil
+}
+
+// MarshalJSONArray encodes {{if .IsArray}}array{{else}}slice{{end}} into JSON{{if .IsPointer}}, nil elements are encoded as null{{end}}
+func (a {{.HelperType}}) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+{{if .IsPointer}}		if item == nil {
+			enc.Null()
+			continue
+		}
+{{else if .IsRawJSON}}		if len(item) == 0 {
+			enc.Null()
+			continue
+		}
+{{end}}		enc.{{.EncodingMethod}}({{.ValueEncode}})
+	}
+}
+
+// IsNil checks if {{if .IsArray}}array{{else}}slice{{end}} is empty
+func (a {{.HelperType}}) IsNil() bool {
+	return len(a) == 0
+}
+`,
+	presenceMethods: `
+// FieldsSet returns the fields present in the decoded JSON, identified by their index in the JSON fields
+func ({{.Receiver}}) FieldsSet() gojay.FieldSet {
+	return {{.Accessor}}
+}
+{{range .Fields}}
+// Has{{.Name}} returns true if the {{.Key}} key was present in the decoded JSON
+func ({{$.Receiver}}) Has{{.Name}}() bool {
+	return {{$.Accessor}}.Has({{.Index}})
+}
+{{end}}`,
 	resetStruct: `
 // Reset reset fields 
 func ({{.Receiver}}) Reset()  {
@@ -295,6 +642,78 @@ func ({{.Receiver}}) Reset()  {
 	embeddedStructInit: `if {{.Accessor}} == nil { 
 		{{.Accessor}} = {{.Init}}
 	}`,
+	testFileCode: `// Code generated by Gojay. DO NOT EDIT.
+{{if .BuildTag}}
+{{.BuildTag}}
+{{end}}
+
+package {{.Pkg}}
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay/gojaytest"
+)
+{{range .Types}}
+var gojay{{.TestName}}Tester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &{{.Name}}{} },
+	TagName: "{{$.TagName}}",
+}
+
+func Test{{.TestName}}_GojayRoundTrip(t *testing.T) {
+	gojay{{.TestName}}Tester.RoundTrip(t)
+}
+{{if .EncodingJSON}}
+func Test{{.TestName}}_GojayEncodingJSON(t *testing.T) {
+	gojay{{.TestName}}Tester.EncodingJSON(t)
+}
+{{end}}
+func Benchmark{{.TestName}}_GojayMarshal(b *testing.B) {
+	gojay{{.TestName}}Tester.BenchmarkMarshal(b)
+}
+
+func Benchmark{{.TestName}}_GojayUnmarshal(b *testing.B) {
+	gojay{{.TestName}}Tester.BenchmarkUnmarshal(b)
+}
+{{end}}`,
+	embeddedKeysInit: `	switch k {
+{{range .}}	case {{.Keys}}:
+		{{.Init}}
+{{end}}	}`,
+	typeParamEncodeKey: `// {{.Name}} encodes a value of the type parameter {{.TypeParam}} of {{.Owner}} with a key, for the type arguments of {{.Owner}} in the package
+func {{.Name}}[{{.TypeParam}} any](enc *gojay.Encoder, key string, v *{{.TypeParam}}) {
+	switch {{if .Arguments}}value := {{end}}any(v).(type) {
+{{range .Arguments}}	case *{{.Type}}:
+		enc.{{.Method}}Key{{.OmitEmpty}}(key, {{.Value}})
+{{end}}	default:
+		enc.SetError(fmt.Errorf("unsupported type %T for type parameter {{.TypeParam}} of {{.Owner}}", *v))
+	}
+}`,
+	typeParamEncode: `// {{.Name}} encodes a value of the type parameter {{.TypeParam}} of {{.Owner}}, for the type arguments of {{.Owner}} in the package
+func {{.Name}}[{{.TypeParam}} any](enc *gojay.Encoder, v *{{.TypeParam}}) {
+	if v == nil {
+		enc.Null()
+		return
+	}
+	switch {{if .Arguments}}value := {{end}}any(v).(type) {
+{{range .Arguments}}	case *{{.Type}}:
+		enc.{{.Method}}({{.Value}})
+{{end}}	default:
+		enc.SetError(fmt.Errorf("unsupported type %T for type parameter {{.TypeParam}} of {{.Owner}}", *v))
+	}
+}`,
+	typeParamDecode: `// {{.Name}} decodes a value of the type parameter {{.TypeParam}} of {{.Owner}}, for the type arguments of {{.Owner}} in the package
+func {{.Name}}[{{.TypeParam}} any](dec *gojay.Decoder, v *{{.TypeParam}}) error {
+	switch {{if .Arguments}}value := {{end}}any(v).(type) {
+{{range .Arguments}}	case *{{.Type}}:{{if or (not .BuiltIn) (eq .BuiltIn .Type)}}
+		return dec.{{.Method}}(value){{else}}
+		var decoded {{.BuiltIn}}
+		err := dec.{{.Method}}(&decoded)
+		*value = {{.Type}}(decoded)
+		return err{{end}}
+{{end}}	}
+	return fmt.Errorf("unsupported type %T for type parameter {{.TypeParam}} of {{.Owner}}", *v)
+}`,
 }
 
 func expandTemplate(namespace string, dictionary map[int]string, key int, data interface{}) (string, error) {
@@ -0,0 +1,27 @@
+package annotation
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessage_RoundTrip(t *testing.T) {
+	var msg = &Message{
+		Id:       1,
+		Name:     "annotated",
+		Ints:     []int{1, 2},
+		Labels:   map[string]string{"a": "b"},
+		Sub:      &SubMessage{Id: 2, Description: "sub"},
+		Messages: []*SubMessage{{Id: 3, Description: "item"}},
+	}
+	data, err := gojay.MarshalJSONObject(msg)
+	require.Nil(t, err)
+	require.JSONEq(t, `{"id":1,"name":"annotated","ints":[1,2],"labels":{"a":"b"},"sub":{"id":2,"description":"sub"},"messages":[{"id":3,"description":"item"}]}`, string(data))
+
+	var message = &Message{}
+	err = gojay.UnmarshalJSONObject(data, message)
+	require.Nil(t, err)
+	require.Equal(t, msg, message)
+}
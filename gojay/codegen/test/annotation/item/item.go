@@ -0,0 +1,8 @@
+package item
+
+//gojay:generate
+type Item struct {
+	Sku   string  `json:"sku"`
+	Price float64 `json:"price"`
+	Tags  []int   `json:"tags"`
+}
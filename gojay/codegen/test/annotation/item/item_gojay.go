@@ -0,0 +1,69 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package item
+
+import (
+	"github.com/francoispqt/gojay"
+)
+
+type Ints []int
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Ints) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value int
+	if err := dec.Int(&value); err != nil {
+		return err
+	}
+	*a = append(*a, value)
+	return nil
+}
+
+// MarshalJSONArray encodes arrays into JSON
+func (a Ints) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Int(item)
+	}
+}
+
+// IsNil checks if array is nil
+func (a Ints) IsNil() bool {
+	return len(a) == 0
+}
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (i *Item) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("sku", i.Sku)
+	enc.Float64Key("price", i.Price)
+	var tagsSlice = Ints(i.Tags)
+	enc.ArrayKey("tags", tagsSlice)
+}
+
+// IsNil checks if instance is nil
+func (i *Item) IsNil() bool {
+	return i == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (i *Item) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "sku":
+		return dec.String(&i.Sku)
+
+	case "price":
+		return dec.Float64(&i.Price)
+
+	case "tags":
+		var aSlice = Ints{}
+		err := dec.Array(&aSlice)
+		if err == nil && len(aSlice) > 0 {
+			i.Tags = []int(aSlice)
+		}
+		return err
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (i *Item) NKeys() int { return 3 }
@@ -0,0 +1,30 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package item
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay/gojaytest"
+)
+
+var gojayItemTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Item{} },
+	TagName: "json",
+}
+
+func TestItem_GojayRoundTrip(t *testing.T) {
+	gojayItemTester.RoundTrip(t)
+}
+
+func TestItem_GojayEncodingJSON(t *testing.T) {
+	gojayItemTester.EncodingJSON(t)
+}
+
+func BenchmarkItem_GojayMarshal(b *testing.B) {
+	gojayItemTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkItem_GojayUnmarshal(b *testing.B) {
+	gojayItemTester.BenchmarkUnmarshal(b)
+}
@@ -0,0 +1,17 @@
+package annotation
+
+// Message is generated along with its dependencies
+//gojay:generate
+type Message struct {
+	Id       int               `json:"id"`
+	Name     string            `json:"name"`
+	Ints     []int             `json:"ints"`
+	Labels   map[string]string `json:"labels"`
+	Sub      *SubMessage       `json:"sub"`
+	Messages []*SubMessage     `json:"messages"`
+}
+
+// Ignored has no annotation, no code is generated for it
+type Ignored struct {
+	Id int
+}
@@ -0,0 +1,163 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package annotation
+
+import (
+	"github.com/francoispqt/gojay"
+	"sort"
+)
+
+type SubMessagesPtr []*SubMessage
+
+func (s *SubMessagesPtr) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value = &SubMessage{}
+	if err := dec.Object(value); err != nil {
+		return err
+	}
+	*s = append(*s, value)
+	return nil
+}
+
+func (s SubMessagesPtr) MarshalJSONArray(enc *gojay.Encoder) {
+	for i := range s {
+		enc.Object(s[i])
+	}
+}
+
+func (s SubMessagesPtr) IsNil() bool {
+	return len(s) == 0
+}
+
+type Ints []int
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Ints) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value int
+	if err := dec.Int(&value); err != nil {
+		return err
+	}
+	*a = append(*a, value)
+	return nil
+}
+
+// MarshalJSONArray encodes arrays into JSON
+func (a Ints) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Int(item)
+	}
+}
+
+// IsNil checks if array is nil
+func (a Ints) IsNil() bool {
+	return len(a) == 0
+}
+
+type StringStringMap map[string]string
+
+// UnmarshalJSONObject decodes JSON object members into map
+func (m StringStringMap) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	var value string
+	if err := dec.String(&value); err != nil {
+		return err
+	}
+	m[k] = value
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal, 0 decodes all keys
+func (m StringStringMap) NKeys() int {
+	return 0
+}
+
+// MarshalJSONObject encodes map into JSON, keys are sorted to get a deterministic output
+func (m StringStringMap) MarshalJSONObject(enc *gojay.Encoder) {
+	var keys = make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var value = m[k]
+		enc.StringKey(k, value)
+	}
+}
+
+// IsNil checks if map is empty
+func (m StringStringMap) IsNil() bool {
+	return len(m) == 0
+}
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (m *Message) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.IntKey("id", m.Id)
+	enc.StringKey("name", m.Name)
+	var intsSlice = Ints(m.Ints)
+	enc.ArrayKey("ints", intsSlice)
+	if m.Labels == nil {
+		enc.NullKey("labels")
+	} else {
+		enc.ObjectKey("labels", StringStringMap(m.Labels))
+	}
+	enc.ObjectKey("sub", m.Sub)
+	var messagesSlice = SubMessagesPtr(m.Messages)
+	enc.ArrayKey("messages", messagesSlice)
+}
+
+// IsNil checks if instance is nil
+func (m *Message) IsNil() bool {
+	return m == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "id":
+		return dec.Int(&m.Id)
+
+	case "name":
+		return dec.String(&m.Name)
+
+	case "ints":
+		var aSlice = Ints{}
+		err := dec.Array(&aSlice)
+		if err == nil && len(aSlice) > 0 {
+			m.Ints = []int(aSlice)
+		}
+		return err
+
+	case "labels":
+		if dec.Peek() == gojay.NullKind {
+			m.Labels = nil
+			return dec.Skip()
+		}
+		var aMap = StringStringMap{}
+		err := dec.Object(aMap)
+		if err == nil {
+			m.Labels = map[string]string(aMap)
+		}
+		return err
+
+	case "sub":
+		var value = &SubMessage{}
+		err := dec.Object(value)
+		if err == nil {
+			m.Sub = value
+		}
+
+		return err
+
+	case "messages":
+		var aSlice = SubMessagesPtr{}
+		err := dec.Array(&aSlice)
+		if err == nil && len(aSlice) > 0 {
+			m.Messages = []*SubMessage(aSlice)
+		}
+		return err
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (m *Message) NKeys() int { return 6 }
@@ -0,0 +1,30 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package annotation
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay/gojaytest"
+)
+
+var gojayMessageTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Message{} },
+	TagName: "json",
+}
+
+func TestMessage_GojayRoundTrip(t *testing.T) {
+	gojayMessageTester.RoundTrip(t)
+}
+
+func TestMessage_GojayEncodingJSON(t *testing.T) {
+	gojayMessageTester.EncodingJSON(t)
+}
+
+func BenchmarkMessage_GojayMarshal(b *testing.B) {
+	gojayMessageTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkMessage_GojayUnmarshal(b *testing.B) {
+	gojayMessageTester.BenchmarkUnmarshal(b)
+}
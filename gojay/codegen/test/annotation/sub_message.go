@@ -0,0 +1,6 @@
+package annotation
+
+type SubMessage struct {
+	Id          int    `json:"id"`
+	Description string `json:"description"`
+}
@@ -0,0 +1,35 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package annotation
+
+import (
+	"github.com/francoispqt/gojay"
+)
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (m *SubMessage) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.IntKey("id", m.Id)
+	enc.StringKey("description", m.Description)
+}
+
+// IsNil checks if instance is nil
+func (m *SubMessage) IsNil() bool {
+	return m == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (m *SubMessage) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "id":
+		return dec.Int(&m.Id)
+
+	case "description":
+		return dec.String(&m.Description)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (m *SubMessage) NKeys() int { return 2 }
@@ -0,0 +1,30 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package annotation
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay/gojaytest"
+)
+
+var gojaySubMessageTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &SubMessage{} },
+	TagName: "json",
+}
+
+func TestSubMessage_GojayRoundTrip(t *testing.T) {
+	gojaySubMessageTester.RoundTrip(t)
+}
+
+func TestSubMessage_GojayEncodingJSON(t *testing.T) {
+	gojaySubMessageTester.EncodingJSON(t)
+}
+
+func BenchmarkSubMessage_GojayMarshal(b *testing.B) {
+	gojaySubMessageTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkSubMessage_GojayUnmarshal(b *testing.B) {
+	gojaySubMessageTester.BenchmarkUnmarshal(b)
+}
@@ -5,6 +5,8 @@ package basic_struct
 import (
 	"database/sql"
 	"github.com/francoispqt/gojay"
+	"sort"
+	"strconv"
 	"time"
 )
 
@@ -50,6 +52,30 @@ func (s SubMessages) IsNil() bool {
 	return len(s) == 0
 }
 
+type Float32s []float32
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Float32s) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value float32
+	if err := dec.Float32(&value); err != nil {
+		return err
+	}
+	*a = append(*a, value)
+	return nil
+}
+
+// MarshalJSONArray encodes arrays into JSON
+func (a Float32s) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Float32(item)
+	}
+}
+
+// IsNil checks if array is nil
+func (a Float32s) IsNil() bool {
+	return len(a) == 0
+}
+
 type Ints []int
 
 // UnmarshalJSONArray decodes JSON array elements into slice
@@ -74,12 +100,12 @@ func (a Ints) IsNil() bool {
 	return len(a) == 0
 }
 
-type Float32s []float32
+type Strings []string
 
 // UnmarshalJSONArray decodes JSON array elements into slice
-func (a *Float32s) UnmarshalJSONArray(dec *gojay.Decoder) error {
-	var value float32
-	if err := dec.Float32(&value); err != nil {
+func (a *Strings) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value string
+	if err := dec.String(&value); err != nil {
 		return err
 	}
 	*a = append(*a, value)
@@ -87,66 +113,232 @@ func (a *Float32s) UnmarshalJSONArray(dec *gojay.Decoder) error {
 }
 
 // MarshalJSONArray encodes arrays into JSON
-func (a Float32s) MarshalJSONArray(enc *gojay.Encoder) {
+func (a Strings) MarshalJSONArray(enc *gojay.Encoder) {
 	for _, item := range a {
-		enc.Float32(item)
+		enc.String(item)
 	}
 }
 
 // IsNil checks if array is nil
-func (a Float32s) IsNil() bool {
+func (a Strings) IsNil() bool {
 	return len(a) == 0
 }
 
-// MarshalJSONObject implements MarshalerJSONObject
-func (m *SubMessage) MarshalJSONObject(enc *gojay.Encoder) {
-	enc.IntKey("Id", m.Id)
-	enc.StringKey("Description", m.Description)
-	enc.TimeKey("StartTime", &m.StartTime, time.RFC3339)
-	if m.EndTime != nil {
-		enc.TimeKey("EndTime", m.EndTime, time.RFC3339)
+type Int64StringMap map[int64]string
+
+// UnmarshalJSONObject decodes JSON object members into map
+func (m Int64StringMap) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	var value string
+	if err := dec.String(&value); err != nil {
+		return err
 	}
+	key, err := strconv.ParseInt(k, 10, 64)
+	if err != nil {
+		return err
+	}
+	m[int64(key)] = value
+	return nil
 }
 
-// IsNil checks if instance is nil
-func (m *SubMessage) IsNil() bool {
-	return m == nil
+// NKeys returns the number of keys to unmarshal, 0 decodes all keys
+func (m Int64StringMap) NKeys() int {
+	return 0
 }
 
-// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
-func (m *SubMessage) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+// MarshalJSONObject encodes map into JSON, keys are sorted to get a deterministic output
+func (m Int64StringMap) MarshalJSONObject(enc *gojay.Encoder) {
+	var keys = make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return strconv.FormatInt(int64(keys[i]), 10) < strconv.FormatInt(int64(keys[j]), 10)
+	})
+	for _, k := range keys {
+		var value = m[k]
+		enc.StringKey(strconv.FormatInt(int64(k), 10), value)
+	}
+}
 
-	switch k {
-	case "Id":
-		return dec.Int(&m.Id)
+// IsNil checks if map is empty
+func (m Int64StringMap) IsNil() bool {
+	return len(m) == 0
+}
 
-	case "Description":
-		return dec.String(&m.Description)
+type StringSubMessagePtrMap map[string]*SubMessage
 
-	case "StartTime":
-		var format = time.RFC3339
-		var value = time.Time{}
-		err := dec.Time(&value, format)
-		if err == nil {
-			m.StartTime = value
-		}
+// UnmarshalJSONObject decodes JSON object members into map
+func (m StringSubMessagePtrMap) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	var value = &SubMessage{}
+	if err := dec.Object(value); err != nil {
 		return err
+	}
+	m[k] = value
+	return nil
+}
 
-	case "EndTime":
-		var format = time.RFC3339
-		var value = &time.Time{}
-		err := dec.Time(value, format)
-		if err == nil {
-			m.EndTime = value
-		}
+// NKeys returns the number of keys to unmarshal, 0 decodes all keys
+func (m StringSubMessagePtrMap) NKeys() int {
+	return 0
+}
+
+// MarshalJSONObject encodes map into JSON, keys are sorted to get a deterministic output
+func (m StringSubMessagePtrMap) MarshalJSONObject(enc *gojay.Encoder) {
+	var keys = make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var value = m[k]
+		enc.ObjectKey(k, value)
+	}
+}
+
+// IsNil checks if map is empty
+func (m StringSubMessagePtrMap) IsNil() bool {
+	return len(m) == 0
+}
+
+type StringStringsMap map[string][]string
+
+// UnmarshalJSONObject decodes JSON object members into map
+func (m StringStringsMap) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	var value = Strings{}
+	if err := dec.Array(&value); err != nil {
 		return err
+	}
+	m[k] = []string(value)
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal, 0 decodes all keys
+func (m StringStringsMap) NKeys() int {
+	return 0
+}
+
+// MarshalJSONObject encodes map into JSON, keys are sorted to get a deterministic output
+func (m StringStringsMap) MarshalJSONObject(enc *gojay.Encoder) {
+	var keys = make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var value = m[k]
+		enc.ArrayKey(k, Strings(value))
+	}
+}
 
+// IsNil checks if map is empty
+func (m StringStringsMap) IsNil() bool {
+	return len(m) == 0
+}
+
+type StringIntMap map[string]int
+
+// UnmarshalJSONObject decodes JSON object members into map
+func (m StringIntMap) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	var value int
+	if err := dec.Int(&value); err != nil {
+		return err
 	}
+	m[k] = value
 	return nil
 }
 
-// NKeys returns the number of keys to unmarshal
-func (m *SubMessage) NKeys() int { return 4 }
+// NKeys returns the number of keys to unmarshal, 0 decodes all keys
+func (m StringIntMap) NKeys() int {
+	return 0
+}
+
+// MarshalJSONObject encodes map into JSON, keys are sorted to get a deterministic output
+func (m StringIntMap) MarshalJSONObject(enc *gojay.Encoder) {
+	var keys = make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var value = m[k]
+		enc.IntKey(k, value)
+	}
+}
+
+// IsNil checks if map is empty
+func (m StringIntMap) IsNil() bool {
+	return len(m) == 0
+}
+
+type StringStringMap map[string]string
+
+// UnmarshalJSONObject decodes JSON object members into map
+func (m StringStringMap) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	var value string
+	if err := dec.String(&value); err != nil {
+		return err
+	}
+	m[k] = value
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal, 0 decodes all keys
+func (m StringStringMap) NKeys() int {
+	return 0
+}
+
+// MarshalJSONObject encodes map into JSON, keys are sorted to get a deterministic output
+func (m StringStringMap) MarshalJSONObject(enc *gojay.Encoder) {
+	var keys = make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var value = m[k]
+		enc.StringKey(k, value)
+	}
+}
+
+// IsNil checks if map is empty
+func (m StringStringMap) IsNil() bool {
+	return len(m) == 0
+}
+
+type StringUintMap map[string]uint
+
+// UnmarshalJSONObject decodes JSON object members into map
+func (m StringUintMap) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	var value uint64
+	if err := dec.Uint64(&value); err != nil {
+		return err
+	}
+	m[k] = uint(value)
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal, 0 decodes all keys
+func (m StringUintMap) NKeys() int {
+	return 0
+}
+
+// MarshalJSONObject encodes map into JSON, keys are sorted to get a deterministic output
+func (m StringUintMap) MarshalJSONObject(enc *gojay.Encoder) {
+	var keys = make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var value = m[k]
+		enc.Uint64Key(k, uint64(value))
+	}
+}
+
+// IsNil checks if map is empty
+func (m StringUintMap) IsNil() bool {
+	return len(m) == 0
+}
 
 // MarshalJSONObject implements MarshalerJSONObject
 func (m *Message) MarshalJSONObject(enc *gojay.Encoder) {
@@ -169,6 +361,41 @@ func (m *Message) MarshalJSONObject(enc *gojay.Encoder) {
 	if m.SQLNullString != nil {
 		enc.SQLNullStringKey("SQLNullString", m.SQLNullString)
 	}
+	if m.Labels == nil {
+		enc.NullKey("Labels")
+	} else {
+		enc.ObjectKey("Labels", StringStringMap(m.Labels))
+	}
+	if m.Counts == nil {
+		enc.NullKey("Counts")
+	} else {
+		enc.ObjectKey("Counts", StringIntMap(m.Counts))
+	}
+	if m.SubMessagesZ == nil {
+		enc.NullKey("SubMessagesZ")
+	} else {
+		enc.ObjectKey("SubMessagesZ", StringSubMessagePtrMap(m.SubMessagesZ))
+	}
+	if m.Tags == nil {
+		enc.NullKey("Tags")
+	} else {
+		enc.ObjectKey("Tags", StringStringsMap(m.Tags))
+	}
+	if m.Codes == nil {
+		enc.NullKey("Codes")
+	} else {
+		enc.ObjectKey("Codes", Int64StringMap(m.Codes))
+	}
+	if m.Sizes == nil {
+		enc.NullKey("Sizes")
+	} else {
+		enc.ObjectKey("Sizes", StringUintMap(m.Sizes))
+	}
+	if m.Empty == nil {
+		enc.NullKey("Empty")
+	} else {
+		enc.ObjectKey("Empty", StringIntMap(m.Empty))
+	}
 }
 
 // IsNil checks if instance is nil
@@ -259,9 +486,143 @@ func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 		}
 		return err
 
+	case "Labels":
+		if dec.Peek() == gojay.NullKind {
+			m.Labels = nil
+			return dec.Skip()
+		}
+		var aMap = StringStringMap{}
+		err := dec.Object(aMap)
+		if err == nil {
+			m.Labels = map[string]string(aMap)
+		}
+		return err
+
+	case "Counts":
+		if dec.Peek() == gojay.NullKind {
+			m.Counts = nil
+			return dec.Skip()
+		}
+		var aMap = StringIntMap{}
+		err := dec.Object(aMap)
+		if err == nil {
+			m.Counts = map[string]int(aMap)
+		}
+		return err
+
+	case "SubMessagesZ":
+		if dec.Peek() == gojay.NullKind {
+			m.SubMessagesZ = nil
+			return dec.Skip()
+		}
+		var aMap = StringSubMessagePtrMap{}
+		err := dec.Object(aMap)
+		if err == nil {
+			m.SubMessagesZ = map[string]*SubMessage(aMap)
+		}
+		return err
+
+	case "Tags":
+		if dec.Peek() == gojay.NullKind {
+			m.Tags = nil
+			return dec.Skip()
+		}
+		var aMap = StringStringsMap{}
+		err := dec.Object(aMap)
+		if err == nil {
+			m.Tags = map[string][]string(aMap)
+		}
+		return err
+
+	case "Codes":
+		if dec.Peek() == gojay.NullKind {
+			m.Codes = nil
+			return dec.Skip()
+		}
+		var aMap = Int64StringMap{}
+		err := dec.Object(aMap)
+		if err == nil {
+			m.Codes = map[int64]string(aMap)
+		}
+		return err
+
+	case "Sizes":
+		if dec.Peek() == gojay.NullKind {
+			m.Sizes = nil
+			return dec.Skip()
+		}
+		var aMap = StringUintMap{}
+		err := dec.Object(aMap)
+		if err == nil {
+			m.Sizes = map[string]uint(aMap)
+		}
+		return err
+
+	case "Empty":
+		if dec.Peek() == gojay.NullKind {
+			m.Empty = nil
+			return dec.Skip()
+		}
+		var aMap = StringIntMap{}
+		err := dec.Object(aMap)
+		if err == nil {
+			m.Empty = map[string]int(aMap)
+		}
+		return err
+
 	}
 	return nil
 }
 
 // NKeys returns the number of keys to unmarshal
-func (m *Message) NKeys() int { return 12 }
+func (m *Message) NKeys() int { return 19 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (m *SubMessage) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.IntKey("Id", m.Id)
+	enc.StringKey("Description", m.Description)
+	enc.TimeKey("StartTime", &m.StartTime, time.RFC3339)
+	if m.EndTime != nil {
+		enc.TimeKey("EndTime", m.EndTime, time.RFC3339)
+	}
+}
+
+// IsNil checks if instance is nil
+func (m *SubMessage) IsNil() bool {
+	return m == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (m *SubMessage) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "Id":
+		return dec.Int(&m.Id)
+
+	case "Description":
+		return dec.String(&m.Description)
+
+	case "StartTime":
+		var format = time.RFC3339
+		var value = time.Time{}
+		err := dec.Time(&value, format)
+		if err == nil {
+			m.StartTime = value
+		}
+		return err
+
+	case "EndTime":
+		var format = time.RFC3339
+		var value = &time.Time{}
+		err := dec.Time(value, format)
+		if err == nil {
+			m.EndTime = value
+		}
+		return err
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (m *SubMessage) NKeys() int { return 4 }
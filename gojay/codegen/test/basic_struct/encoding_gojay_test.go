@@ -0,0 +1,43 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package basic_struct
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay/gojaytest"
+)
+
+var gojayMessageTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Message{} },
+	TagName: "",
+}
+
+func TestMessage_GojayRoundTrip(t *testing.T) {
+	gojayMessageTester.RoundTrip(t)
+}
+
+func BenchmarkMessage_GojayMarshal(b *testing.B) {
+	gojayMessageTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkMessage_GojayUnmarshal(b *testing.B) {
+	gojayMessageTester.BenchmarkUnmarshal(b)
+}
+
+var gojaySubMessageTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &SubMessage{} },
+	TagName: "",
+}
+
+func TestSubMessage_GojayRoundTrip(t *testing.T) {
+	gojaySubMessageTester.RoundTrip(t)
+}
+
+func BenchmarkSubMessage_GojayMarshal(b *testing.B) {
+	gojaySubMessageTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkSubMessage_GojayUnmarshal(b *testing.B) {
+	gojaySubMessageTester.BenchmarkUnmarshal(b)
+}
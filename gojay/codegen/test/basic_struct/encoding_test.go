@@ -46,6 +46,18 @@ var msg = &Message{
 		String: "test",
 		Valid:  true,
 	},
+	Labels: map[string]string{"b": "2", "a": "1"},
+	Counts: map[string]int{"x": 10},
+	SubMessagesZ: map[string]*SubMessage{
+		"first": &SubMessage{
+			Id:          7102,
+			Description: "abch",
+		},
+	},
+	Tags:  map[string][]string{"t": []string{"u", "v"}},
+	Codes: map[int64]string{404: "not found", 200: "ok"},
+	Sizes: map[string]uint{"s": 1, "xl": 18446744073709551615},
+	Empty: map[string]int{},
 }
 
 var jsonData = `{
@@ -93,7 +105,20 @@ var jsonData = `{
   ],
   "IsTrue": true,
   "Payload": "123",
-  "SQLNullString": "test"
+  "SQLNullString": "test",
+  "Labels": {"a": "1", "b": "2"},
+  "Counts": {"x": 10},
+  "SubMessagesZ": {
+    "first": {
+      "Id": 7102,
+      "Description": "abch",
+      "StartTime": "0001-01-01T00:00:00Z"
+    }
+  },
+  "Tags": {"t": ["u", "v"]},
+  "Codes": {"200": "ok", "404": "not found"},
+  "Sizes": {"s": 1, "xl": 18446744073709551615},
+  "Empty": {}
 }`
 
 func TestMessage_Unmarshal(t *testing.T) {
@@ -116,3 +141,29 @@ func TestMessage_Marshal(t *testing.T) {
 
 	require.JSONEq(t, jsonData, JSON)
 }
+
+func TestMessage_MarshalMapKeysSorted(t *testing.T) {
+	var message = *msg
+	message.Labels = map[string]string{"c": "3", "a": "1", "b": "2"}
+	message.Codes = map[int64]string{404: "not found", 200: "ok", 12: "x", 9: "y"}
+	for i := 0; i < 10; i++ {
+		data, err := gojay.MarshalJSONObject(&message)
+		require.Nil(t, err)
+		require.Contains(t, string(data), `"Labels":{"a":"1","b":"2","c":"3"}`)
+		require.Contains(t, string(data), `"Codes":{"12":"x","200":"ok","404":"not found","9":"y"}`)
+	}
+}
+
+func TestMessage_MarshalNilMap(t *testing.T) {
+	var message = *msg
+	message.Labels = nil
+	data, err := gojay.MarshalJSONObject(&message)
+	require.Nil(t, err)
+	require.Contains(t, string(data), `"Labels":null`)
+	require.Contains(t, string(data), `"Empty":{}`)
+	var decoded = &Message{}
+	err = gojay.UnmarshalJSONObject(data, decoded)
+	require.Nil(t, err)
+	require.Nil(t, decoded.Labels)
+	require.NotNil(t, decoded.Empty)
+}
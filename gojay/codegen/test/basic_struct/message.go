@@ -15,4 +15,11 @@ type Message struct {
 	IsTrue        *bool
 	Payload       []byte
 	SQLNullString *sql.NullString
+	Labels        map[string]string
+	Counts        map[string]int
+	SubMessagesZ  map[string]*SubMessage
+	Tags          map[string][]string
+	Codes         map[int64]string
+	Sizes         map[string]uint
+	Empty         map[string]int
 }
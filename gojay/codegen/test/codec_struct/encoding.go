@@ -0,0 +1,227 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package codec_struct
+
+import (
+	"github.com/francoispqt/gojay"
+	"github.com/francoispqt/gojay/gojay/codegen/test/codec_struct/ids"
+	"strconv"
+)
+
+type Strings []string
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Strings) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value string
+	if err := dec.String(&value); err != nil {
+		return err
+	}
+	*a = append(*a, value)
+	return nil
+}
+
+// MarshalJSONArray encodes arrays into JSON
+func (a Strings) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.String(item)
+	}
+}
+
+// IsNil checks if array is nil
+func (a Strings) IsNil() bool {
+	return len(a) == 0
+}
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (m *Message) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.ObjectKey("numbers", m.Numbers)
+	ids.EncodeID(enc, "ref", m.Ref)
+	EncodeOptionalID(enc, "owner", m.Owner)
+	EncodePath(enc, "path", m.Path)
+}
+
+// IsNil checks if instance is nil
+func (m *Message) IsNil() bool {
+	return m == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "numbers":
+		var value = &Numbers{}
+		err := dec.Object(value)
+		if err == nil {
+			m.Numbers = value
+		}
+
+		return err
+
+	case "ref":
+		return ids.DecodeID(dec, &m.Ref)
+
+	case "owner":
+		return DecodeOptionalID(dec, &m.Owner)
+
+	case "path":
+		return DecodePath(dec, &m.Path)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (m *Message) NKeys() int { return 4 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (n *Numbers) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("id", strconv.FormatInt(int64(n.ID), 10))
+	if n.Count != nil {
+		enc.StringKey("count", strconv.FormatInt(int64(*n.Count), 10))
+	} else {
+		enc.NullKey("count")
+	}
+	enc.StringKey("ratio", strconv.FormatFloat(float64(n.Ratio), 'f', -1, 64))
+	enc.StringKey("enabled", strconv.FormatBool(bool(n.Enabled)))
+	if n.Size != 0 {
+		enc.StringKey("size", strconv.FormatUint(uint64(n.Size), 10))
+	}
+	enc.StringKey("level", strconv.FormatInt(int64(n.Level), 10))
+	if n.Limit != nil {
+		enc.StringKey("limit", strconv.FormatUint(uint64(*n.Limit), 10))
+	}
+	var tagsSlice = Strings(n.Tags)
+	enc.ArrayKey("tags", tagsSlice)
+}
+
+// IsNil checks if instance is nil
+func (n *Numbers) IsNil() bool {
+	return n == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (n *Numbers) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "id":
+		if dec.Peek() == gojay.NullKind {
+			return dec.Skip()
+		}
+		var text string
+		if err := dec.String(&text); err != nil {
+			return err
+		}
+		parsed, err := strconv.ParseInt(text, 10, 64)
+		if err == nil {
+			var value = int64(parsed)
+			n.ID = value
+		}
+		return err
+
+	case "count":
+		if dec.Peek() == gojay.NullKind {
+			n.Count = nil
+			return dec.Skip()
+		}
+		var text string
+		if err := dec.String(&text); err != nil {
+			return err
+		}
+		parsed, err := strconv.ParseInt(text, 10, 32)
+		if err == nil {
+			var value = int32(parsed)
+			n.Count = &value
+		}
+		return err
+
+	case "ratio":
+		if dec.Peek() == gojay.NullKind {
+			return dec.Skip()
+		}
+		var text string
+		if err := dec.String(&text); err != nil {
+			return err
+		}
+		parsed, err := strconv.ParseFloat(text, 64)
+		if err == nil {
+			var value = float64(parsed)
+			n.Ratio = value
+		}
+		return err
+
+	case "enabled":
+		if dec.Peek() == gojay.NullKind {
+			return dec.Skip()
+		}
+		var text string
+		if err := dec.String(&text); err != nil {
+			return err
+		}
+		parsed, err := strconv.ParseBool(text)
+		if err == nil {
+			var value = bool(parsed)
+			n.Enabled = value
+		}
+		return err
+
+	case "size":
+		if dec.Peek() == gojay.NullKind {
+			return dec.Skip()
+		}
+		var text string
+		if err := dec.String(&text); err != nil {
+			return err
+		}
+		parsed, err := strconv.ParseUint(text, 10, 16)
+		if err == nil {
+			var value = uint16(parsed)
+			n.Size = value
+		}
+		return err
+
+	case "level":
+		if dec.Peek() == gojay.NullKind {
+			return dec.Skip()
+		}
+		var text string
+		if err := dec.String(&text); err != nil {
+			return err
+		}
+		parsed, err := strconv.ParseInt(text, 10, 0)
+		if err == nil {
+			var value = Level(parsed)
+			n.Level = value
+		}
+		return err
+
+	case "limit":
+		if dec.Peek() == gojay.NullKind {
+			n.Limit = nil
+			return dec.Skip()
+		}
+		var text string
+		if err := dec.String(&text); err != nil {
+			return err
+		}
+		parsed, err := strconv.ParseUint(text, 10, 64)
+		if err == nil {
+			var value = uint64(parsed)
+			n.Limit = &value
+		}
+		return err
+
+	case "tags":
+		var aSlice = Strings{}
+		err := dec.Array(&aSlice)
+		if err == nil && len(aSlice) > 0 {
+			n.Tags = []string(aSlice)
+		}
+		return err
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (n *Numbers) NKeys() int { return 8 }
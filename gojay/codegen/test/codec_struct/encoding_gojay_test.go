@@ -0,0 +1,47 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package codec_struct
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay/gojaytest"
+)
+
+var gojayMessageTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Message{} },
+	TagName: "json",
+}
+
+func TestMessage_GojayRoundTrip(t *testing.T) {
+	gojayMessageTester.RoundTrip(t)
+}
+
+func BenchmarkMessage_GojayMarshal(b *testing.B) {
+	gojayMessageTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkMessage_GojayUnmarshal(b *testing.B) {
+	gojayMessageTester.BenchmarkUnmarshal(b)
+}
+
+var gojayNumbersTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Numbers{} },
+	TagName: "json",
+}
+
+func TestNumbers_GojayRoundTrip(t *testing.T) {
+	gojayNumbersTester.RoundTrip(t)
+}
+
+func TestNumbers_GojayEncodingJSON(t *testing.T) {
+	gojayNumbersTester.EncodingJSON(t)
+}
+
+func BenchmarkNumbers_GojayMarshal(b *testing.B) {
+	gojayNumbersTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkNumbers_GojayUnmarshal(b *testing.B) {
+	gojayNumbersTester.BenchmarkUnmarshal(b)
+}
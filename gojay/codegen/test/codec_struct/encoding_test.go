@@ -0,0 +1,80 @@
+package codec_struct
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/francoispqt/gojay"
+	"github.com/francoispqt/gojay/gojay/codegen/test/codec_struct/ids"
+	"github.com/stretchr/testify/require"
+)
+
+var count = int32(12)
+var limit = uint64(1 << 63)
+
+var numbers = []*Numbers{
+	{Tags: []string{}},
+	{ID: 9007199254740993, Count: &count, Ratio: 0.25, Enabled: true, Size: 3, Level: -2, Limit: &limit, Tags: []string{"a"}},
+}
+
+var msg = &Message{
+	Numbers: &Numbers{ID: 9007199254740993, Count: &count, Ratio: 1.5, Enabled: true, Tags: []string{"a", "b"}},
+	Ref:     ids.ID{Prefix: "usr", Number: 12},
+	Owner:   &ids.ID{Prefix: "org", Number: 3},
+	Path:    []string{"a", "b", "c"},
+}
+
+var jsonData = `{
+  "numbers": {"id": "9007199254740993", "count": "12", "ratio": "1.5", "enabled": "true", "level": "0", "tags": ["a", "b"]},
+  "ref": "usr-12",
+  "owner": "org-3",
+  "path": "a/b/c"
+}`
+
+func TestNumbers_MarshalGolden(t *testing.T) {
+	for _, value := range numbers {
+		expected, err := json.Marshal(value)
+		require.Nil(t, err)
+		actual, err := gojay.MarshalJSONObject(value)
+		require.Nil(t, err)
+		require.Equal(t, string(expected), string(actual))
+	}
+}
+
+func TestNumbers_UnmarshalGolden(t *testing.T) {
+	for _, input := range []string{
+		`{"id":"-42","count":"7","ratio":"2.5","enabled":"false","size":"8","level":"3","limit":"18446744073709551615"}`,
+		`{"id":null,"count":null,"limit":null}`,
+	} {
+		expected := &Numbers{}
+		require.Nil(t, json.Unmarshal([]byte(input), expected))
+		actual := &Numbers{Count: &count}
+		require.Nil(t, gojay.UnmarshalJSONObject([]byte(input), actual))
+		if expected.Count == nil {
+			// encoding/json sets a pointer to nil on null, as the generated code does
+			require.Nil(t, actual.Count)
+			actual.Count = nil
+		}
+		require.Equal(t, expected, actual, input)
+	}
+}
+
+func TestNumbers_UnmarshalInvalid(t *testing.T) {
+	for _, input := range []string{`{"id":12}`, `{"id":"abc"}`, `{"size":"70000"}`} {
+		err := gojay.UnmarshalJSONObject([]byte(input), &Numbers{})
+		require.NotNil(t, err, input)
+	}
+}
+
+func TestMessage_Unmarshal(t *testing.T) {
+	message := &Message{}
+	err := gojay.UnmarshalJSONObject([]byte(jsonData), message)
+	require.Nil(t, err)
+	require.Equal(t, msg, message)
+}
+
+func TestMessage_Marshal(t *testing.T) {
+	data, err := gojay.MarshalJSONObject(msg)
+	require.Nil(t, err)
+	require.JSONEq(t, jsonData, string(data))
+}
@@ -0,0 +1,42 @@
+package ids
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/francoispqt/gojay"
+)
+
+// ID represents a prefixed identifier, i.e usr-12
+type ID struct {
+	Prefix string
+	Number int64
+}
+
+func (i ID) String() string {
+	return i.Prefix + "-" + strconv.FormatInt(i.Number, 10)
+}
+
+// EncodeID encodes an ID as a string
+func EncodeID(enc *gojay.Encoder, key string, v ID) {
+	enc.StringKey(key, v.String())
+}
+
+// DecodeID decodes an ID from a string
+func DecodeID(dec *gojay.Decoder, v *ID) error {
+	var text string
+	if err := dec.String(&text); err != nil {
+		return err
+	}
+	index := strings.LastIndex(text, "-")
+	if index == -1 {
+		return fmt.Errorf("invalid id: %q", text)
+	}
+	number, err := strconv.ParseInt(text[index+1:], 10, 64)
+	if err != nil {
+		return err
+	}
+	*v = ID{Prefix: text[:index], Number: number}
+	return nil
+}
@@ -0,0 +1,64 @@
+package codec_struct
+
+import (
+	"strings"
+
+	"github.com/francoispqt/gojay"
+	"github.com/francoispqt/gojay/gojay/codegen/test/codec_struct/ids"
+)
+
+type Level int
+
+type Numbers struct {
+	ID      int64    `json:"id,string"`
+	Count   *int32   `json:"count,string"`
+	Ratio   float64  `json:"ratio,string"`
+	Enabled bool     `json:"enabled,string"`
+	Size    uint16   `json:"size,string,omitempty"`
+	Level   Level    `json:"level,string"`
+	Limit   *uint64  `json:"limit,string,omitempty"`
+	Tags    []string `json:"tags,string"`
+}
+
+type Message struct {
+	Numbers *Numbers `json:"numbers"`
+	Ref     ids.ID   `json:"ref" gojay:"codec=ids.ID"`
+	Owner   *ids.ID  `json:"owner" gojay:"codec=OptionalID"`
+	Path    []string `json:"path" gojay:"codec=Path"`
+}
+
+// EncodeOptionalID encodes an optional ID, omitting it if nil
+func EncodeOptionalID(enc *gojay.Encoder, key string, v *ids.ID) {
+	if v != nil {
+		ids.EncodeID(enc, key, *v)
+	}
+}
+
+// DecodeOptionalID decodes an optional ID
+func DecodeOptionalID(dec *gojay.Decoder, v **ids.ID) error {
+	var id ids.ID
+	if err := ids.DecodeID(dec, &id); err != nil {
+		return err
+	}
+	*v = &id
+	return nil
+}
+
+// EncodePath encodes a path as a slash separated string
+func EncodePath(enc *gojay.Encoder, key string, v []string) {
+	enc.StringKey(key, strings.Join(v, "/"))
+}
+
+// DecodePath decodes a slash separated string
+func DecodePath(dec *gojay.Decoder, v *[]string) error {
+	var text string
+	if err := dec.String(&text); err != nil {
+		return err
+	}
+	if text == "" {
+		*v = nil
+		return nil
+	}
+	*v = strings.Split(text, "/")
+	return nil
+}
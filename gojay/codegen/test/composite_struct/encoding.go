@@ -0,0 +1,836 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package composite_struct
+
+import (
+	"github.com/francoispqt/gojay"
+	"sort"
+)
+
+type PointsPtr []*Point
+
+func (s *PointsPtr) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value = &Point{}
+	if err := dec.Object(value); err != nil {
+		return err
+	}
+	*s = append(*s, value)
+	return nil
+}
+
+func (s PointsPtr) MarshalJSONArray(enc *gojay.Encoder) {
+	for i := range s {
+		enc.Object(s[i])
+	}
+}
+
+func (s PointsPtr) IsNil() bool {
+	return len(s) == 0
+}
+
+type LevelArray2 [2]Level
+
+// UnmarshalJSONArray decodes JSON array elements into array, elements beyond the array length are skipped
+func (a *LevelArray2) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var index = dec.Index()
+	if index >= len(a) {
+		return dec.Skip()
+	}
+	var value int
+	if err := dec.Int(&value); err != nil {
+		return err
+	}
+	var item = Level(value)
+	a[index] = item
+	return nil
+}
+
+// MarshalJSONArray encodes array into JSON
+func (a LevelArray2) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Int(int(item))
+	}
+}
+
+// IsNil checks if array is empty
+func (a LevelArray2) IsNil() bool {
+	return len(a) == 0
+}
+
+type PointArray2 [2]Point
+
+// UnmarshalJSONArray decodes JSON array elements into array, elements beyond the array length are skipped
+func (a *PointArray2) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var index = dec.Index()
+	if index >= len(a) {
+		return dec.Skip()
+	}
+	var value Point
+	if err := dec.Object(&value); err != nil {
+		return err
+	}
+	var item = value
+	a[index] = item
+	return nil
+}
+
+// MarshalJSONArray encodes array into JSON
+func (a PointArray2) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Object(&item)
+	}
+}
+
+// IsNil checks if array is empty
+func (a PointArray2) IsNil() bool {
+	return len(a) == 0
+}
+
+type IntArray3Array2 [2][3]int
+
+// UnmarshalJSONArray decodes JSON array elements into array, elements beyond the array length are skipped
+func (a *IntArray3Array2) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var index = dec.Index()
+	if index >= len(a) {
+		return dec.Skip()
+	}
+	var value = IntArray3{}
+	if err := dec.Array(&value); err != nil {
+		return err
+	}
+	var item = [3]int(value)
+	a[index] = item
+	return nil
+}
+
+// MarshalJSONArray encodes array into JSON
+func (a IntArray3Array2) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Array(IntArray3(item))
+	}
+}
+
+// IsNil checks if array is empty
+func (a IntArray3Array2) IsNil() bool {
+	return len(a) == 0
+}
+
+type Float64Array2 [2]float64
+
+// UnmarshalJSONArray decodes JSON array elements into array, elements beyond the array length are skipped
+func (a *Float64Array2) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var index = dec.Index()
+	if index >= len(a) {
+		return dec.Skip()
+	}
+	var value float64
+	if err := dec.Float64(&value); err != nil {
+		return err
+	}
+	var item = value
+	a[index] = item
+	return nil
+}
+
+// MarshalJSONArray encodes array into JSON
+func (a Float64Array2) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Float64(item)
+	}
+}
+
+// IsNil checks if array is empty
+func (a Float64Array2) IsNil() bool {
+	return len(a) == 0
+}
+
+type UintArray2 [2]uint
+
+// UnmarshalJSONArray decodes JSON array elements into array, elements beyond the array length are skipped
+func (a *UintArray2) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var index = dec.Index()
+	if index >= len(a) {
+		return dec.Skip()
+	}
+	var value uint64
+	if err := dec.Uint64(&value); err != nil {
+		return err
+	}
+	var item = uint(value)
+	a[index] = item
+	return nil
+}
+
+// MarshalJSONArray encodes array into JSON
+func (a UintArray2) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Uint64(uint64(item))
+	}
+}
+
+// IsNil checks if array is empty
+func (a UintArray2) IsNil() bool {
+	return len(a) == 0
+}
+
+type IntArray3 [3]int
+
+// UnmarshalJSONArray decodes JSON array elements into array, elements beyond the array length are skipped
+func (a *IntArray3) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var index = dec.Index()
+	if index >= len(a) {
+		return dec.Skip()
+	}
+	var value int
+	if err := dec.Int(&value); err != nil {
+		return err
+	}
+	var item = value
+	a[index] = item
+	return nil
+}
+
+// MarshalJSONArray encodes array into JSON
+func (a IntArray3) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Int(item)
+	}
+}
+
+// IsNil checks if array is empty
+func (a IntArray3) IsNil() bool {
+	return len(a) == 0
+}
+
+type Uint8Array3 [3]uint8
+
+// UnmarshalJSONArray decodes JSON array elements into array, elements beyond the array length are skipped
+func (a *Uint8Array3) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var index = dec.Index()
+	if index >= len(a) {
+		return dec.Skip()
+	}
+	var value uint8
+	if err := dec.Uint8(&value); err != nil {
+		return err
+	}
+	var item = value
+	a[index] = item
+	return nil
+}
+
+// MarshalJSONArray encodes array into JSON
+func (a Uint8Array3) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Uint8(item)
+	}
+}
+
+// IsNil checks if array is empty
+func (a Uint8Array3) IsNil() bool {
+	return len(a) == 0
+}
+
+type PtrIntsSlice []*[]int
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *PtrIntsSlice) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	if dec.Peek() == gojay.NullKind {
+		*a = append(*a, nil)
+		return dec.Skip()
+	}
+	var value = Ints{}
+	if err := dec.Array(&value); err != nil {
+		return err
+	}
+	var item = []int(value)
+	*a = append(*a, &item)
+	return nil
+}
+
+// MarshalJSONArray encodes slice into JSON, nil elements are encoded as null
+func (a PtrIntsSlice) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		if item == nil {
+			enc.Null()
+			continue
+		}
+		enc.Array(Ints(*item))
+	}
+}
+
+// IsNil checks if slice is empty
+func (a PtrIntsSlice) IsNil() bool {
+	return len(a) == 0
+}
+
+type Float64Array2Slice [][2]float64
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Float64Array2Slice) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value = Float64Array2{}
+	if err := dec.Array(&value); err != nil {
+		return err
+	}
+	var item = [2]float64(value)
+	*a = append(*a, item)
+	return nil
+}
+
+// MarshalJSONArray encodes slice into JSON
+func (a Float64Array2Slice) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Array(Float64Array2(item))
+	}
+}
+
+// IsNil checks if slice is empty
+func (a Float64Array2Slice) IsNil() bool {
+	return len(a) == 0
+}
+
+type PointsPtrSlice [][]*Point
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *PointsPtrSlice) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value = PointsPtr{}
+	if err := dec.Array(&value); err != nil {
+		return err
+	}
+	var item = []*Point(value)
+	*a = append(*a, item)
+	return nil
+}
+
+// MarshalJSONArray encodes slice into JSON
+func (a PointsPtrSlice) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Array(PointsPtr(item))
+	}
+}
+
+// IsNil checks if slice is empty
+func (a PointsPtrSlice) IsNil() bool {
+	return len(a) == 0
+}
+
+type Float64Array2SliceSlice [][][2]float64
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Float64Array2SliceSlice) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value = Float64Array2Slice{}
+	if err := dec.Array(&value); err != nil {
+		return err
+	}
+	var item = [][2]float64(value)
+	*a = append(*a, item)
+	return nil
+}
+
+// MarshalJSONArray encodes slice into JSON
+func (a Float64Array2SliceSlice) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Array(Float64Array2Slice(item))
+	}
+}
+
+// IsNil checks if slice is empty
+func (a Float64Array2SliceSlice) IsNil() bool {
+	return len(a) == 0
+}
+
+type BytesSlice [][]byte
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *BytesSlice) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value gojay.EmbeddedJSON
+	if err := dec.EmbeddedJSON(&value); err != nil {
+		return err
+	}
+	var item = []byte(value)
+	*a = append(*a, item)
+	return nil
+}
+
+// MarshalJSONArray encodes slice into JSON
+func (a BytesSlice) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		if len(item) == 0 {
+			enc.Null()
+			continue
+		}
+		enc.AddEmbeddedJSON((*gojay.EmbeddedJSON)(&item))
+	}
+}
+
+// IsNil checks if slice is empty
+func (a BytesSlice) IsNil() bool {
+	return len(a) == 0
+}
+
+type Float64sSlice [][]float64
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Float64sSlice) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value = Float64s{}
+	if err := dec.Array(&value); err != nil {
+		return err
+	}
+	var item = []float64(value)
+	*a = append(*a, item)
+	return nil
+}
+
+// MarshalJSONArray encodes slice into JSON
+func (a Float64sSlice) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Array(Float64s(item))
+	}
+}
+
+// IsNil checks if slice is empty
+func (a Float64sSlice) IsNil() bool {
+	return len(a) == 0
+}
+
+type IntsSlice [][]int
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *IntsSlice) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value = Ints{}
+	if err := dec.Array(&value); err != nil {
+		return err
+	}
+	var item = []int(value)
+	*a = append(*a, item)
+	return nil
+}
+
+// MarshalJSONArray encodes slice into JSON
+func (a IntsSlice) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Array(Ints(item))
+	}
+}
+
+// IsNil checks if slice is empty
+func (a IntsSlice) IsNil() bool {
+	return len(a) == 0
+}
+
+type StringIntMapSlice []map[string]int
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *StringIntMapSlice) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value = StringIntMap{}
+	if err := dec.Object(value); err != nil {
+		return err
+	}
+	var item = map[string]int(value)
+	*a = append(*a, item)
+	return nil
+}
+
+// MarshalJSONArray encodes slice into JSON
+func (a StringIntMapSlice) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Object(StringIntMap(item))
+	}
+}
+
+// IsNil checks if slice is empty
+func (a StringIntMapSlice) IsNil() bool {
+	return len(a) == 0
+}
+
+type Float64s []float64
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Float64s) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value float64
+	if err := dec.Float64(&value); err != nil {
+		return err
+	}
+	*a = append(*a, value)
+	return nil
+}
+
+// MarshalJSONArray encodes arrays into JSON
+func (a Float64s) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Float64(item)
+	}
+}
+
+// IsNil checks if array is nil
+func (a Float64s) IsNil() bool {
+	return len(a) == 0
+}
+
+type Ints []int
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Ints) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value int
+	if err := dec.Int(&value); err != nil {
+		return err
+	}
+	*a = append(*a, value)
+	return nil
+}
+
+// MarshalJSONArray encodes arrays into JSON
+func (a Ints) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Int(item)
+	}
+}
+
+// IsNil checks if array is nil
+func (a Ints) IsNil() bool {
+	return len(a) == 0
+}
+
+type Strings []string
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Strings) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value string
+	if err := dec.String(&value); err != nil {
+		return err
+	}
+	*a = append(*a, value)
+	return nil
+}
+
+// MarshalJSONArray encodes arrays into JSON
+func (a Strings) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.String(item)
+	}
+}
+
+// IsNil checks if array is nil
+func (a Strings) IsNil() bool {
+	return len(a) == 0
+}
+
+type StringIntsSliceMap map[string][][]int
+
+// UnmarshalJSONObject decodes JSON object members into map
+func (m StringIntsSliceMap) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	var value = IntsSlice{}
+	if err := dec.Array(&value); err != nil {
+		return err
+	}
+	m[k] = [][]int(value)
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal, 0 decodes all keys
+func (m StringIntsSliceMap) NKeys() int {
+	return 0
+}
+
+// MarshalJSONObject encodes map into JSON, keys are sorted to get a deterministic output
+func (m StringIntsSliceMap) MarshalJSONObject(enc *gojay.Encoder) {
+	var keys = make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var value = m[k]
+		enc.ArrayKey(k, IntsSlice(value))
+	}
+}
+
+// IsNil checks if map is empty
+func (m StringIntsSliceMap) IsNil() bool {
+	return len(m) == 0
+}
+
+type StringIntMap map[string]int
+
+// UnmarshalJSONObject decodes JSON object members into map
+func (m StringIntMap) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	var value int
+	if err := dec.Int(&value); err != nil {
+		return err
+	}
+	m[k] = value
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal, 0 decodes all keys
+func (m StringIntMap) NKeys() int {
+	return 0
+}
+
+// MarshalJSONObject encodes map into JSON, keys are sorted to get a deterministic output
+func (m StringIntMap) MarshalJSONObject(enc *gojay.Encoder) {
+	var keys = make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var value = m[k]
+		enc.IntKey(k, value)
+	}
+}
+
+// IsNil checks if map is empty
+func (m StringIntMap) IsNil() bool {
+	return len(m) == 0
+}
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (b *Batch) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.ArrayKey("blobs", BytesSlice(b.Blobs))
+}
+
+// IsNil checks if instance is nil
+func (b *Batch) IsNil() bool {
+	return b == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (b *Batch) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "blobs":
+		var value = BytesSlice{}
+		err := dec.Array(&value)
+		if err == nil && len(value) > 0 {
+			b.Blobs = [][]byte(value)
+		}
+		return err
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (b *Batch) NKeys() int { return 1 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (m *Message) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.ArrayKey("matrix", Float64sSlice(m.Matrix))
+	enc.ArrayKey("polygons", Float64Array2SliceSlice(m.Polygons))
+	enc.ArrayKey("grid", IntArray3Array2(m.Grid))
+	enc.ArrayKey("rgb", Uint8Array3(m.RGB))
+	enc.ArrayKey("levels", LevelArray2(m.Levels))
+	enc.ArrayKey("corners", PointArray2(m.Corners))
+	enc.ArrayKey("paths", PointsPtrSlice(m.Paths))
+	if m.Tags != nil {
+		enc.ArrayKey("tags", Strings(*m.Tags))
+	} else {
+		enc.NullKey("tags")
+	}
+	if m.Notes != nil {
+		enc.ArrayKey("notes", Strings(*m.Notes))
+	}
+	if m.Points != nil {
+		enc.ArrayKey("points", PointsPtr(*m.Points))
+	} else {
+		enc.NullKey("points")
+	}
+	enc.ArrayKey("counts", StringIntMapSlice(m.Counts))
+	enc.ArrayKey("optional", PtrIntsSlice(m.Optional))
+	if m.Anchor != nil {
+		enc.ArrayKey("anchor", Float64Array2(*m.Anchor))
+	} else {
+		enc.NullKey("anchor")
+	}
+	if m.Series == nil {
+		enc.NullKey("series")
+	} else {
+		enc.ObjectKey("series", StringIntsSliceMap(m.Series))
+	}
+	enc.ArrayKey("sizes", UintArray2(m.Sizes))
+}
+
+// IsNil checks if instance is nil
+func (m *Message) IsNil() bool {
+	return m == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "matrix":
+		var value = Float64sSlice{}
+		err := dec.Array(&value)
+		if err == nil && len(value) > 0 {
+			m.Matrix = [][]float64(value)
+		}
+		return err
+
+	case "polygons":
+		var value = Float64Array2SliceSlice{}
+		err := dec.Array(&value)
+		if err == nil && len(value) > 0 {
+			m.Polygons = [][][2]float64(value)
+		}
+		return err
+
+	case "grid":
+		var value = IntArray3Array2{}
+		err := dec.Array(&value)
+		if err == nil && len(value) > 0 {
+			m.Grid = [2][3]int(value)
+		}
+		return err
+
+	case "rgb":
+		var value = Uint8Array3{}
+		err := dec.Array(&value)
+		if err == nil && len(value) > 0 {
+			m.RGB = [3]uint8(value)
+		}
+		return err
+
+	case "levels":
+		var value = LevelArray2{}
+		err := dec.Array(&value)
+		if err == nil && len(value) > 0 {
+			m.Levels = [2]Level(value)
+		}
+		return err
+
+	case "corners":
+		var value = PointArray2{}
+		err := dec.Array(&value)
+		if err == nil && len(value) > 0 {
+			m.Corners = [2]Point(value)
+		}
+		return err
+
+	case "paths":
+		var value = PointsPtrSlice{}
+		err := dec.Array(&value)
+		if err == nil && len(value) > 0 {
+			m.Paths = [][]*Point(value)
+		}
+		return err
+
+	case "tags":
+		if dec.Peek() == gojay.NullKind {
+			m.Tags = nil
+			return dec.Skip()
+		}
+		var value = Strings{}
+		err := dec.Array(&value)
+		if err == nil {
+			m.Tags = (*[]string)(&value)
+		}
+		return err
+
+	case "notes":
+		if dec.Peek() == gojay.NullKind {
+			m.Notes = nil
+			return dec.Skip()
+		}
+		var value = Strings{}
+		err := dec.Array(&value)
+		if err == nil {
+			m.Notes = (*[]string)(&value)
+		}
+		return err
+
+	case "points":
+		if dec.Peek() == gojay.NullKind {
+			m.Points = nil
+			return dec.Skip()
+		}
+		var value = PointsPtr{}
+		err := dec.Array(&value)
+		if err == nil {
+			m.Points = (*[]*Point)(&value)
+		}
+		return err
+
+	case "counts":
+		var value = StringIntMapSlice{}
+		err := dec.Array(&value)
+		if err == nil && len(value) > 0 {
+			m.Counts = []map[string]int(value)
+		}
+		return err
+
+	case "optional":
+		var value = PtrIntsSlice{}
+		err := dec.Array(&value)
+		if err == nil && len(value) > 0 {
+			m.Optional = []*[]int(value)
+		}
+		return err
+
+	case "anchor":
+		if dec.Peek() == gojay.NullKind {
+			m.Anchor = nil
+			return dec.Skip()
+		}
+		var value = Float64Array2{}
+		err := dec.Array(&value)
+		if err == nil {
+			m.Anchor = (*[2]float64)(&value)
+		}
+		return err
+
+	case "series":
+		if dec.Peek() == gojay.NullKind {
+			m.Series = nil
+			return dec.Skip()
+		}
+		var aMap = StringIntsSliceMap{}
+		err := dec.Object(aMap)
+		if err == nil {
+			m.Series = map[string][][]int(aMap)
+		}
+		return err
+
+	case "sizes":
+		var value = UintArray2{}
+		err := dec.Array(&value)
+		if err == nil && len(value) > 0 {
+			m.Sizes = [2]uint(value)
+		}
+		return err
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (m *Message) NKeys() int { return 15 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (p *Point) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.Float64Key("x", p.X)
+	enc.Float64Key("y", p.Y)
+}
+
+// IsNil checks if instance is nil
+func (p *Point) IsNil() bool {
+	return p == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (p *Point) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "x":
+		return dec.Float64(&p.X)
+
+	case "y":
+		return dec.Float64(&p.Y)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (p *Point) NKeys() int { return 2 }
@@ -0,0 +1,68 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package composite_struct
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay/gojaytest"
+)
+
+var gojayBatchTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Batch{} },
+	TagName: "json",
+}
+
+func TestBatch_GojayRoundTrip(t *testing.T) {
+	gojayBatchTester.RoundTrip(t)
+}
+
+func BenchmarkBatch_GojayMarshal(b *testing.B) {
+	gojayBatchTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkBatch_GojayUnmarshal(b *testing.B) {
+	gojayBatchTester.BenchmarkUnmarshal(b)
+}
+
+var gojayMessageTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Message{} },
+	TagName: "json",
+}
+
+func TestMessage_GojayRoundTrip(t *testing.T) {
+	gojayMessageTester.RoundTrip(t)
+}
+
+func TestMessage_GojayEncodingJSON(t *testing.T) {
+	gojayMessageTester.EncodingJSON(t)
+}
+
+func BenchmarkMessage_GojayMarshal(b *testing.B) {
+	gojayMessageTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkMessage_GojayUnmarshal(b *testing.B) {
+	gojayMessageTester.BenchmarkUnmarshal(b)
+}
+
+var gojayPointTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Point{} },
+	TagName: "json",
+}
+
+func TestPoint_GojayRoundTrip(t *testing.T) {
+	gojayPointTester.RoundTrip(t)
+}
+
+func TestPoint_GojayEncodingJSON(t *testing.T) {
+	gojayPointTester.EncodingJSON(t)
+}
+
+func BenchmarkPoint_GojayMarshal(b *testing.B) {
+	gojayPointTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkPoint_GojayUnmarshal(b *testing.B) {
+	gojayPointTester.BenchmarkUnmarshal(b)
+}
@@ -0,0 +1,68 @@
+package composite_struct
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/francoispqt/gojay"
+	"github.com/stretchr/testify/require"
+)
+
+var tags = []string{"a", "b"}
+var values = []int{1, 2}
+
+var msg = &Message{
+	Matrix:   [][]float64{{1, 2.5}, {}, {-3}},
+	Polygons: [][][2]float64{{{0, 0}, {1, 0}, {1, 1}}, {{2.5, -1}}},
+	Grid:     [2][3]int{{1, 2, 3}, {4, 5, 6}},
+	RGB:      [3]uint8{255, 128, 0},
+	Levels:   [2]Level{3, 1},
+	Corners:  [2]Point{{X: 1, Y: 2}, {X: 3, Y: 4}},
+	Paths:    [][]*Point{{{X: 1}, {Y: 2}}, {}},
+	Tags:     &tags,
+	Points:   &[]*Point{{Y: 2}},
+	Counts:   []map[string]int{{"a": 1, "b": 2}, {}},
+	Optional: []*[]int{&values, nil},
+	Anchor:   &[2]float64{1.5, -2},
+	Series:   map[string][][]int{"b": {{3}}, "a": {{1, 2}, {}}},
+	Sizes:    [2]uint{7, 1 << 40},
+}
+
+func TestMessage_MarshalGolden(t *testing.T) {
+	// nil slices are encoded as empty arrays, as by the slice helper types
+	var empty = &Message{Matrix: [][]float64{}, Polygons: [][][2]float64{}, Paths: [][]*Point{}, Notes: &[]string{}, Counts: []map[string]int{}, Optional: []*[]int{}}
+	for _, value := range []*Message{msg, empty} {
+		expected, err := json.Marshal(value)
+		require.Nil(t, err)
+		actual, err := gojay.MarshalJSONObject(value)
+		require.Nil(t, err)
+		require.Equal(t, string(expected), string(actual))
+	}
+}
+
+func TestMessage_UnmarshalGolden(t *testing.T) {
+	data, err := json.Marshal(msg)
+	require.Nil(t, err)
+	for _, input := range []string{
+		string(data),
+		`{"grid":[[1],[2,3,4,5],[6]],"rgb":[1,2],"corners":[{"x":1}],"anchor":[1,2,3]}`,
+		`{"tags":null,"points":[{"x":1}],"optional":[null,[]],"paths":[[]],"notes":[]}`,
+		`{"series":{"a":[[1],[2,3]],"b":[]}}`,
+	} {
+		expected := &Message{}
+		require.Nil(t, json.Unmarshal([]byte(input), expected))
+		actual := &Message{}
+		require.Nil(t, gojay.UnmarshalJSONObject([]byte(input), actual))
+		require.Equal(t, expected, actual, input)
+	}
+}
+
+func TestBatch_RawJSON(t *testing.T) {
+	batch := &Batch{}
+	require.Nil(t, gojay.UnmarshalJSONObject([]byte(`{"blobs":[{"id":1},[2, 3],"a",null]}`), batch))
+	require.Equal(t, [][]byte{[]byte(`{"id":1}`), []byte(`[2, 3]`), []byte(`"a"`), []byte(`null`)}, batch.Blobs)
+	batch.Blobs = append(batch.Blobs, nil)
+	data, err := gojay.MarshalJSONObject(batch)
+	require.Nil(t, err)
+	require.Equal(t, `{"blobs":[{"id":1},[2, 3],"a",null,null]}`, string(data))
+}
@@ -0,0 +1,31 @@
+package composite_struct
+
+type Level int
+
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+type Message struct {
+	Matrix   [][]float64        `json:"matrix"`
+	Polygons [][][2]float64     `json:"polygons"`
+	Grid     [2][3]int          `json:"grid"`
+	RGB      [3]uint8           `json:"rgb"`
+	Levels   [2]Level           `json:"levels"`
+	Corners  [2]Point           `json:"corners"`
+	Paths    [][]*Point         `json:"paths"`
+	Tags     *[]string          `json:"tags"`
+	Notes    *[]string          `json:"notes,omitempty"`
+	Points   *[]*Point          `json:"points"`
+	Counts   []map[string]int   `json:"counts"`
+	Optional []*[]int           `json:"optional"`
+	Anchor   *[2]float64        `json:"anchor"`
+	Series   map[string][][]int `json:"series"`
+	Sizes    [2]uint            `json:"sizes"`
+}
+
+// Batch holds raw JSON documents, as a []byte field holds one
+type Batch struct {
+	Blobs [][]byte `json:"blobs"`
+}
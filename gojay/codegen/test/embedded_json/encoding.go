@@ -0,0 +1,378 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package embedded_json
+
+import (
+	"github.com/francoispqt/gojay"
+)
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (a *Audit) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("name", a.Name)
+	enc.StringKey("created", a.Created)
+	enc.IntKey("Code", a.Code)
+}
+
+// IsNil checks if instance is nil
+func (a *Audit) IsNil() bool {
+	return a == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (a *Audit) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "name":
+		return dec.String(&a.Name)
+
+	case "created":
+		return dec.String(&a.Created)
+
+	case "Code":
+		return dec.Int(&a.Code)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (a *Audit) NKeys() int { return 3 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (b *Base) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.IntKey("id", b.ID)
+	enc.StringKey("name", b.Name)
+	enc.StringKey("Note", b.Note)
+	enc.IntKey("Code", b.Code)
+	enc.StringKey("Label", b.Label)
+}
+
+// IsNil checks if instance is nil
+func (b *Base) IsNil() bool {
+	return b == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (b *Base) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "id":
+		return dec.Int(&b.ID)
+
+	case "name":
+		return dec.String(&b.Name)
+
+	case "Note":
+		return dec.String(&b.Note)
+
+	case "Code":
+		return dec.Int(&b.Code)
+
+	case "Label":
+		return dec.String(&b.Label)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (b *Base) NKeys() int { return 5 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (i *Inner) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("deep", i.Deep)
+	enc.IntKey("id", i.ID)
+}
+
+// IsNil checks if instance is nil
+func (i *Inner) IsNil() bool {
+	return i == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (i *Inner) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "deep":
+		return dec.String(&i.Deep)
+
+	case "id":
+		return dec.Int(&i.ID)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (i *Inner) NKeys() int { return 2 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (l *Labels) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("Label", l.Text)
+}
+
+// IsNil checks if instance is nil
+func (l *Labels) IsNil() bool {
+	return l == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (l *Labels) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "Label":
+		return dec.String(&l.Text)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (l *Labels) NKeys() int { return 1 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (l *Left) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("deep", l.Inner.Deep)
+	enc.IntKey("id", l.Inner.ID)
+}
+
+// IsNil checks if instance is nil
+func (l *Left) IsNil() bool {
+	return l == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (l *Left) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "deep":
+		return dec.String(&l.Inner.Deep)
+
+	case "id":
+		return dec.Int(&l.Inner.ID)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (l *Left) NKeys() int { return 2 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (m *Message) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.IntKey("id", m.Base.ID)
+	if m.Audit != nil {
+		enc.StringKey("created", m.Audit.Created)
+	}
+	enc.StringKey("Label", m.Labels.Text)
+	if m.Nested != nil && m.Nested.Inner != nil {
+		enc.StringKey("deep", m.Nested.Inner.Deep)
+	}
+	if m.Nested != nil {
+		enc.StringKey("level", m.Nested.Level)
+	}
+	enc.StringKey("hidden", m.hidden.Hidden)
+	enc.ObjectKey("meta", &m.Meta)
+	enc.StringKey("title", m.Title)
+	enc.StringKey("Note", m.Note)
+}
+
+// IsNil checks if instance is nil
+func (m *Message) IsNil() bool {
+	return m == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	switch k {
+	case "created":
+		if m.Audit == nil {
+			m.Audit = &Audit{}
+		}
+	case "deep":
+		if m.Nested == nil {
+			m.Nested = &Nested{}
+		}
+		if m.Nested.Inner == nil {
+			m.Nested.Inner = &Inner{}
+		}
+	case "level":
+		if m.Nested == nil {
+			m.Nested = &Nested{}
+		}
+	}
+	switch k {
+	case "id":
+		return dec.Int(&m.Base.ID)
+
+	case "created":
+		return dec.String(&m.Audit.Created)
+
+	case "Label":
+		return dec.String(&m.Labels.Text)
+
+	case "deep":
+		return dec.String(&m.Nested.Inner.Deep)
+
+	case "level":
+		return dec.String(&m.Nested.Level)
+
+	case "hidden":
+		return dec.String(&m.hidden.Hidden)
+
+	case "meta":
+		err := dec.Object(&m.Meta)
+
+		return err
+
+	case "title":
+		return dec.String(&m.Title)
+
+	case "Note":
+		return dec.String(&m.Note)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (m *Message) NKeys() int { return 9 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (m *Meta) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.IntKey("version", m.Version)
+}
+
+// IsNil checks if instance is nil
+func (m *Meta) IsNil() bool {
+	return m == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (m *Meta) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "version":
+		return dec.Int(&m.Version)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (m *Meta) NKeys() int { return 1 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (n *Nested) MarshalJSONObject(enc *gojay.Encoder) {
+	if n.Inner != nil {
+		enc.StringKey("deep", n.Inner.Deep)
+		enc.IntKey("id", n.Inner.ID)
+	}
+	enc.StringKey("level", n.Level)
+}
+
+// IsNil checks if instance is nil
+func (n *Nested) IsNil() bool {
+	return n == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (n *Nested) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	switch k {
+	case "deep", "id":
+		if n.Inner == nil {
+			n.Inner = &Inner{}
+		}
+	}
+	switch k {
+	case "deep":
+		return dec.String(&n.Inner.Deep)
+
+	case "id":
+		return dec.Int(&n.Inner.ID)
+
+	case "level":
+		return dec.String(&n.Level)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (n *Nested) NKeys() int { return 3 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (r *Right) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("deep", r.Inner.Deep)
+	enc.IntKey("id", r.Inner.ID)
+}
+
+// IsNil checks if instance is nil
+func (r *Right) IsNil() bool {
+	return r == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (r *Right) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "deep":
+		return dec.String(&r.Inner.Deep)
+
+	case "id":
+		return dec.Int(&r.Inner.ID)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (r *Right) NKeys() int { return 2 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (t *Twice) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("kind", t.Kind)
+}
+
+// IsNil checks if instance is nil
+func (t *Twice) IsNil() bool {
+	return t == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (t *Twice) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "kind":
+		return dec.String(&t.Kind)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (t *Twice) NKeys() int { return 1 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (h *hidden) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("hidden", h.Hidden)
+}
+
+// IsNil checks if instance is nil
+func (h *hidden) IsNil() bool {
+	return h == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (h *hidden) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "hidden":
+		return dec.String(&h.Hidden)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (h *hidden) NKeys() int { return 1 }
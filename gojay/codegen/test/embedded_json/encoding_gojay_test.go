@@ -0,0 +1,240 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package embedded_json
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay/gojaytest"
+)
+
+var gojayAuditTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Audit{} },
+	TagName: "json",
+}
+
+func TestAudit_GojayRoundTrip(t *testing.T) {
+	gojayAuditTester.RoundTrip(t)
+}
+
+func TestAudit_GojayEncodingJSON(t *testing.T) {
+	gojayAuditTester.EncodingJSON(t)
+}
+
+func BenchmarkAudit_GojayMarshal(b *testing.B) {
+	gojayAuditTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkAudit_GojayUnmarshal(b *testing.B) {
+	gojayAuditTester.BenchmarkUnmarshal(b)
+}
+
+var gojayBaseTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Base{} },
+	TagName: "json",
+}
+
+func TestBase_GojayRoundTrip(t *testing.T) {
+	gojayBaseTester.RoundTrip(t)
+}
+
+func TestBase_GojayEncodingJSON(t *testing.T) {
+	gojayBaseTester.EncodingJSON(t)
+}
+
+func BenchmarkBase_GojayMarshal(b *testing.B) {
+	gojayBaseTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkBase_GojayUnmarshal(b *testing.B) {
+	gojayBaseTester.BenchmarkUnmarshal(b)
+}
+
+var gojayInnerTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Inner{} },
+	TagName: "json",
+}
+
+func TestInner_GojayRoundTrip(t *testing.T) {
+	gojayInnerTester.RoundTrip(t)
+}
+
+func TestInner_GojayEncodingJSON(t *testing.T) {
+	gojayInnerTester.EncodingJSON(t)
+}
+
+func BenchmarkInner_GojayMarshal(b *testing.B) {
+	gojayInnerTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkInner_GojayUnmarshal(b *testing.B) {
+	gojayInnerTester.BenchmarkUnmarshal(b)
+}
+
+var gojayLabelsTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Labels{} },
+	TagName: "json",
+}
+
+func TestLabels_GojayRoundTrip(t *testing.T) {
+	gojayLabelsTester.RoundTrip(t)
+}
+
+func TestLabels_GojayEncodingJSON(t *testing.T) {
+	gojayLabelsTester.EncodingJSON(t)
+}
+
+func BenchmarkLabels_GojayMarshal(b *testing.B) {
+	gojayLabelsTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkLabels_GojayUnmarshal(b *testing.B) {
+	gojayLabelsTester.BenchmarkUnmarshal(b)
+}
+
+var gojayLeftTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Left{} },
+	TagName: "json",
+}
+
+func TestLeft_GojayRoundTrip(t *testing.T) {
+	gojayLeftTester.RoundTrip(t)
+}
+
+func TestLeft_GojayEncodingJSON(t *testing.T) {
+	gojayLeftTester.EncodingJSON(t)
+}
+
+func BenchmarkLeft_GojayMarshal(b *testing.B) {
+	gojayLeftTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkLeft_GojayUnmarshal(b *testing.B) {
+	gojayLeftTester.BenchmarkUnmarshal(b)
+}
+
+var gojayMessageTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Message{} },
+	TagName: "json",
+}
+
+func TestMessage_GojayRoundTrip(t *testing.T) {
+	gojayMessageTester.RoundTrip(t)
+}
+
+func TestMessage_GojayEncodingJSON(t *testing.T) {
+	gojayMessageTester.EncodingJSON(t)
+}
+
+func BenchmarkMessage_GojayMarshal(b *testing.B) {
+	gojayMessageTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkMessage_GojayUnmarshal(b *testing.B) {
+	gojayMessageTester.BenchmarkUnmarshal(b)
+}
+
+var gojayMetaTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Meta{} },
+	TagName: "json",
+}
+
+func TestMeta_GojayRoundTrip(t *testing.T) {
+	gojayMetaTester.RoundTrip(t)
+}
+
+func TestMeta_GojayEncodingJSON(t *testing.T) {
+	gojayMetaTester.EncodingJSON(t)
+}
+
+func BenchmarkMeta_GojayMarshal(b *testing.B) {
+	gojayMetaTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkMeta_GojayUnmarshal(b *testing.B) {
+	gojayMetaTester.BenchmarkUnmarshal(b)
+}
+
+var gojayNestedTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Nested{} },
+	TagName: "json",
+}
+
+func TestNested_GojayRoundTrip(t *testing.T) {
+	gojayNestedTester.RoundTrip(t)
+}
+
+func TestNested_GojayEncodingJSON(t *testing.T) {
+	gojayNestedTester.EncodingJSON(t)
+}
+
+func BenchmarkNested_GojayMarshal(b *testing.B) {
+	gojayNestedTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkNested_GojayUnmarshal(b *testing.B) {
+	gojayNestedTester.BenchmarkUnmarshal(b)
+}
+
+var gojayRightTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Right{} },
+	TagName: "json",
+}
+
+func TestRight_GojayRoundTrip(t *testing.T) {
+	gojayRightTester.RoundTrip(t)
+}
+
+func TestRight_GojayEncodingJSON(t *testing.T) {
+	gojayRightTester.EncodingJSON(t)
+}
+
+func BenchmarkRight_GojayMarshal(b *testing.B) {
+	gojayRightTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkRight_GojayUnmarshal(b *testing.B) {
+	gojayRightTester.BenchmarkUnmarshal(b)
+}
+
+var gojayTwiceTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Twice{} },
+	TagName: "json",
+}
+
+func TestTwice_GojayRoundTrip(t *testing.T) {
+	gojayTwiceTester.RoundTrip(t)
+}
+
+func TestTwice_GojayEncodingJSON(t *testing.T) {
+	gojayTwiceTester.EncodingJSON(t)
+}
+
+func BenchmarkTwice_GojayMarshal(b *testing.B) {
+	gojayTwiceTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkTwice_GojayUnmarshal(b *testing.B) {
+	gojayTwiceTester.BenchmarkUnmarshal(b)
+}
+
+var gojayHiddenTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &hidden{} },
+	TagName: "json",
+}
+
+func TestHidden_GojayRoundTrip(t *testing.T) {
+	gojayHiddenTester.RoundTrip(t)
+}
+
+func TestHidden_GojayEncodingJSON(t *testing.T) {
+	gojayHiddenTester.EncodingJSON(t)
+}
+
+func BenchmarkHidden_GojayMarshal(b *testing.B) {
+	gojayHiddenTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkHidden_GojayUnmarshal(b *testing.B) {
+	gojayHiddenTester.BenchmarkUnmarshal(b)
+}
@@ -0,0 +1,71 @@
+package embedded_json
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/francoispqt/gojay"
+	"github.com/stretchr/testify/require"
+)
+
+var messages = []*Message{
+	{},
+	{
+		Base:    Base{ID: 1, Name: "base", Note: "base note", Code: 2, Label: "base label"},
+		Audit:   &Audit{Name: "audit", Created: "2020-01-01", Code: 3},
+		Labels:  Labels{Text: "label"},
+		Nested:  &Nested{Inner: &Inner{Deep: "deep", ID: 4}, Level: "level"},
+		hidden:  hidden{Hidden: "hidden"},
+		Meta:    Meta{Version: 5},
+		Title:   "title",
+		Note:    "note",
+		secret:  "secret",
+		Skipped: "skipped",
+	},
+	{
+		Nested: &Nested{Level: "level"},
+	},
+}
+
+var inputs = []string{
+	`{}`,
+	`{"id":1,"name":"name","Note":"note","Code":2,"Label":"label","created":"2020-01-01","deep":"deep","level":"level","hidden":"hidden","meta":{"version":5},"title":"title","secret":"secret","Skipped":"skipped"}`,
+	`{"level":"level"}`,
+	`{"deep":"deep"}`,
+}
+
+func TestMessage_MarshalGolden(t *testing.T) {
+	for _, message := range messages {
+		expected, err := json.Marshal(message)
+		require.Nil(t, err)
+		actual, err := gojay.MarshalJSONObject(message)
+		require.Nil(t, err)
+		require.Equal(t, string(expected), string(actual))
+	}
+}
+
+func TestMessage_UnmarshalGolden(t *testing.T) {
+	for _, input := range inputs {
+		expected := &Message{}
+		require.Nil(t, json.Unmarshal([]byte(input), expected))
+		actual := &Message{}
+		require.Nil(t, gojay.UnmarshalJSONObject([]byte(input), actual))
+		require.Equal(t, expected, actual, input)
+	}
+}
+
+func TestTwice_Golden(t *testing.T) {
+	twice := &Twice{Left: Left{Inner{Deep: "left"}}, Right: &Right{Inner{Deep: "right"}}, Kind: "kind"}
+	expected, err := json.Marshal(twice)
+	require.Nil(t, err)
+	actual, err := gojay.MarshalJSONObject(twice)
+	require.Nil(t, err)
+	require.Equal(t, string(expected), string(actual))
+
+	var input = `{"deep":"deep","id":1,"kind":"kind"}`
+	expectedTwice := &Twice{}
+	require.Nil(t, json.Unmarshal([]byte(input), expectedTwice))
+	actualTwice := &Twice{}
+	require.Nil(t, gojay.UnmarshalJSONObject([]byte(input), actualTwice))
+	require.Equal(t, expectedTwice, actualTwice)
+}
@@ -0,0 +1,66 @@
+package embedded_json
+
+type Base struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Note  string
+	Code  int
+	Label string
+}
+
+type Audit struct {
+	Name    string `json:"name"`
+	Created string `json:"created"`
+	Code    int
+}
+
+type Labels struct {
+	Text string `json:"Label"`
+}
+
+type Inner struct {
+	Deep string `json:"deep"`
+	ID   int    `json:"id"`
+}
+
+type Nested struct {
+	*Inner
+	Level string `json:"level"`
+}
+
+type Meta struct {
+	Version int `json:"version"`
+}
+
+type hidden struct {
+	Hidden string `json:"hidden"`
+}
+
+type Message struct {
+	Base
+	*Audit
+	Labels
+	*Nested
+	hidden
+	Meta    `json:"meta"`
+	Title   string `json:"title"`
+	Note    string
+	secret  string
+	Skipped string `json:"-"`
+}
+
+type Left struct {
+	Inner
+}
+
+type Right struct {
+	Inner
+}
+
+// Twice embeds Inner twice at the same depth, its conflicting fields are dropped,
+// Right is embedded by pointer so that go vet does not report the conflict
+type Twice struct {
+	Left
+	*Right
+	Kind string `json:"kind"`
+}
@@ -7,28 +7,46 @@ import (
 	"time"
 )
 
-type Ints []int
+type SubMessagesPtr []*SubMessage
 
-// UnmarshalJSONArray decodes JSON array elements into slice
-func (a *Ints) UnmarshalJSONArray(dec *gojay.Decoder) error {
-	var value int
-	if err := dec.Int(&value); err != nil {
+func (s *SubMessagesPtr) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value = &SubMessage{}
+	if err := dec.Object(value); err != nil {
 		return err
 	}
-	*a = append(*a, value)
+	*s = append(*s, value)
 	return nil
 }
 
-// MarshalJSONArray encodes arrays into JSON
-func (a Ints) MarshalJSONArray(enc *gojay.Encoder) {
-	for _, item := range a {
-		enc.Int(item)
+func (s SubMessagesPtr) MarshalJSONArray(enc *gojay.Encoder) {
+	for i := range s {
+		enc.Object(s[i])
 	}
 }
 
-// IsNil checks if array is nil
-func (a Ints) IsNil() bool {
-	return len(a) == 0
+func (s SubMessagesPtr) IsNil() bool {
+	return len(s) == 0
+}
+
+type SubMessages []SubMessage
+
+func (s *SubMessages) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value = SubMessage{}
+	if err := dec.Object(&value); err != nil {
+		return err
+	}
+	*s = append(*s, value)
+	return nil
+}
+
+func (s SubMessages) MarshalJSONArray(enc *gojay.Encoder) {
+	for i := range s {
+		enc.Object(&s[i])
+	}
+}
+
+func (s SubMessages) IsNil() bool {
+	return len(s) == 0
 }
 
 type Float64s []float64
@@ -55,104 +73,68 @@ func (a Float64s) IsNil() bool {
 	return len(a) == 0
 }
 
-type SubMessagesPtr []*SubMessage
-
-func (s *SubMessagesPtr) UnmarshalJSONArray(dec *gojay.Decoder) error {
-	var value = &SubMessage{}
-	if err := dec.Object(value); err != nil {
-		return err
-	}
-	*s = append(*s, value)
-	return nil
-}
-
-func (s SubMessagesPtr) MarshalJSONArray(enc *gojay.Encoder) {
-	for i := range s {
-		enc.Object(s[i])
-	}
-}
-
-func (s SubMessagesPtr) IsNil() bool {
-	return len(s) == 0
-}
-
-type SubMessages []SubMessage
+type Ints []int
 
-func (s *SubMessages) UnmarshalJSONArray(dec *gojay.Decoder) error {
-	var value = SubMessage{}
-	if err := dec.Object(&value); err != nil {
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Ints) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value int
+	if err := dec.Int(&value); err != nil {
 		return err
 	}
-	*s = append(*s, value)
+	*a = append(*a, value)
 	return nil
 }
 
-func (s SubMessages) MarshalJSONArray(enc *gojay.Encoder) {
-	for i := range s {
-		enc.Object(&s[i])
+// MarshalJSONArray encodes arrays into JSON
+func (a Ints) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Int(item)
 	}
 }
 
-func (s SubMessages) IsNil() bool {
-	return len(s) == 0
+// IsNil checks if array is nil
+func (a Ints) IsNil() bool {
+	return len(a) == 0
 }
 
 // MarshalJSONObject implements MarshalerJSONObject
-func (m *SubMessage) MarshalJSONObject(enc *gojay.Encoder) {
-	enc.StringKey("Description", m.Description)
-	enc.TimeKey("StartTime", &m.StartTime, time.RFC3339)
-	if m.EndTime != nil {
-		enc.TimeKey("EndTime", m.EndTime, time.RFC3339)
-	}
+func (i *BaseId) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.IntKey("Id", i.Id)
+	enc.StringKey("Name", i.Name)
 }
 
 // IsNil checks if instance is nil
-func (m *SubMessage) IsNil() bool {
-	return m == nil
+func (i *BaseId) IsNil() bool {
+	return i == nil
 }
 
 // UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
-func (m *SubMessage) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+func (i *BaseId) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 
 	switch k {
-	case "Description":
-		return dec.String(&m.Description)
-
-	case "StartTime":
-		var format = time.RFC3339
-		var value = time.Time{}
-		err := dec.Time(&value, format)
-		if err == nil {
-			m.StartTime = value
-		}
-		return err
+	case "Id":
+		return dec.Int(&i.Id)
 
-	case "EndTime":
-		var format = time.RFC3339
-		var value = &time.Time{}
-		err := dec.Time(value, format)
-		if err == nil {
-			m.EndTime = value
-		}
-		return err
+	case "Name":
+		return dec.String(&i.Name)
 
 	}
 	return nil
 }
 
 // NKeys returns the number of keys to unmarshal
-func (m *SubMessage) NKeys() int { return 3 }
+func (i *BaseId) NKeys() int { return 2 }
 
 // MarshalJSONObject implements MarshalerJSONObject
 func (m *Message) MarshalJSONObject(enc *gojay.Encoder) {
 	if m.BaseId != nil {
-		enc.IntKey("Id", m.Id)
-		enc.StringKey("Name", m.Name)
+		enc.IntKey("Id", m.BaseId.Id)
+		enc.StringKey("Name", m.BaseId.Name)
 	}
-	enc.StringKey("Description", m.Description)
-	enc.TimeKey("StartTime", &m.StartTime, time.RFC3339)
-	if m.EndTime != nil {
-		enc.TimeKey("EndTime", m.EndTime, time.RFC3339)
+	enc.StringKey("Description", m.SubMessage.Description)
+	enc.TimeKey("StartTime", &m.SubMessage.StartTime, time.RFC3339)
+	if m.SubMessage.EndTime != nil {
+		enc.TimeKey("EndTime", m.SubMessage.EndTime, time.RFC3339)
 	}
 	enc.Float64Key("Price", m.Price)
 	var intsSlice = Ints(m.Ints)
@@ -177,25 +159,28 @@ func (m *Message) IsNil() bool {
 
 // UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
 func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
-	if m.BaseId == nil {
-		m.BaseId = &BaseId{}
+	switch k {
+	case "Id", "Name":
+		if m.BaseId == nil {
+			m.BaseId = &BaseId{}
+		}
 	}
 	switch k {
 	case "Id":
-		return dec.Int(&m.Id)
+		return dec.Int(&m.BaseId.Id)
 
 	case "Name":
-		return dec.String(&m.Name)
+		return dec.String(&m.BaseId.Name)
 
 	case "Description":
-		return dec.String(&m.Description)
+		return dec.String(&m.SubMessage.Description)
 
 	case "StartTime":
 		var format = time.RFC3339
 		var value = time.Time{}
 		err := dec.Time(&value, format)
 		if err == nil {
-			m.StartTime = value
+			m.SubMessage.StartTime = value
 		}
 		return err
 
@@ -204,7 +189,7 @@ func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 		var value = &time.Time{}
 		err := dec.Time(value, format)
 		if err == nil {
-			m.EndTime = value
+			m.SubMessage.EndTime = value
 		}
 		return err
 
@@ -281,29 +266,47 @@ func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 func (m *Message) NKeys() int { return 14 }
 
 // MarshalJSONObject implements MarshalerJSONObject
-func (i *BaseId) MarshalJSONObject(enc *gojay.Encoder) {
-	enc.IntKey("Id", i.Id)
-	enc.StringKey("Name", i.Name)
+func (m *SubMessage) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("Description", m.Description)
+	enc.TimeKey("StartTime", &m.StartTime, time.RFC3339)
+	if m.EndTime != nil {
+		enc.TimeKey("EndTime", m.EndTime, time.RFC3339)
+	}
 }
 
 // IsNil checks if instance is nil
-func (i *BaseId) IsNil() bool {
-	return i == nil
+func (m *SubMessage) IsNil() bool {
+	return m == nil
 }
 
 // UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
-func (i *BaseId) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+func (m *SubMessage) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 
 	switch k {
-	case "Id":
-		return dec.Int(&i.Id)
+	case "Description":
+		return dec.String(&m.Description)
 
-	case "Name":
-		return dec.String(&i.Name)
+	case "StartTime":
+		var format = time.RFC3339
+		var value = time.Time{}
+		err := dec.Time(&value, format)
+		if err == nil {
+			m.StartTime = value
+		}
+		return err
+
+	case "EndTime":
+		var format = time.RFC3339
+		var value = &time.Time{}
+		err := dec.Time(value, format)
+		if err == nil {
+			m.EndTime = value
+		}
+		return err
 
 	}
 	return nil
 }
 
 // NKeys returns the number of keys to unmarshal
-func (i *BaseId) NKeys() int { return 2 }
+func (m *SubMessage) NKeys() int { return 3 }
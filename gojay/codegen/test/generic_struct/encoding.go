@@ -0,0 +1,294 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+//go:build go1.18
+
+package generic_struct
+
+import (
+	"fmt"
+	"github.com/francoispqt/gojay"
+)
+
+// decodeEnvelopeM decodes a value of the type parameter M of Envelope, for the type arguments of Envelope in the package
+func decodeEnvelopeM[M any](dec *gojay.Decoder, v *M) error {
+	switch value := any(v).(type) {
+	case *int:
+		return dec.Int(value)
+	case *string:
+		return dec.String(value)
+	}
+	return fmt.Errorf("unsupported type %T for type parameter M of Envelope", *v)
+}
+
+// decodeEnvelopeT decodes a value of the type parameter T of Envelope, for the type arguments of Envelope in the package
+func decodeEnvelopeT[T any](dec *gojay.Decoder, v *T) error {
+	switch value := any(v).(type) {
+	case *Item:
+		return dec.Object(value)
+	case *int:
+		return dec.Int(value)
+	}
+	return fmt.Errorf("unsupported type %T for type parameter T of Envelope", *v)
+}
+
+// decodePageT decodes a value of the type parameter T of Page, for the type arguments of Page in the package
+func decodePageT[T any](dec *gojay.Decoder, v *T) error {
+	switch value := any(v).(type) {
+	case *Item:
+		return dec.Object(value)
+	case *Level:
+		var decoded uint64
+		err := dec.Uint64(&decoded)
+		*value = Level(decoded)
+		return err
+	case *int:
+		return dec.Int(value)
+	}
+	return fmt.Errorf("unsupported type %T for type parameter T of Page", *v)
+}
+
+// encodeEnvelopeMKey encodes a value of the type parameter M of Envelope with a key, for the type arguments of Envelope in the package
+func encodeEnvelopeMKey[M any](enc *gojay.Encoder, key string, v *M) {
+	switch value := any(v).(type) {
+	case *int:
+		enc.IntKey(key, *value)
+	case *string:
+		enc.StringKey(key, *value)
+	default:
+		enc.SetError(fmt.Errorf("unsupported type %T for type parameter M of Envelope", *v))
+	}
+}
+
+// encodeEnvelopeTKey encodes a value of the type parameter T of Envelope with a key, for the type arguments of Envelope in the package
+func encodeEnvelopeTKey[T any](enc *gojay.Encoder, key string, v *T) {
+	switch value := any(v).(type) {
+	case *Item:
+		enc.ObjectKey(key, value)
+	case *int:
+		enc.IntKey(key, *value)
+	default:
+		enc.SetError(fmt.Errorf("unsupported type %T for type parameter T of Envelope", *v))
+	}
+}
+
+// encodePageT encodes a value of the type parameter T of Page, for the type arguments of Page in the package
+func encodePageT[T any](enc *gojay.Encoder, v *T) {
+	if v == nil {
+		enc.Null()
+		return
+	}
+	switch value := any(v).(type) {
+	case *Item:
+		enc.Object(value)
+	case *Level:
+		enc.Uint64(uint64(*value))
+	case *int:
+		enc.Int(*value)
+	default:
+		enc.SetError(fmt.Errorf("unsupported type %T for type parameter T of Page", *v))
+	}
+}
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (e *Envelope[T, M]) MarshalJSONObject(enc *gojay.Encoder) {
+	if e.Page != nil {
+		enc.ObjectKey("page", e.Page)
+	} else {
+		enc.NullKey("page")
+	}
+	encodeEnvelopeMKey(enc, "meta", &e.Meta)
+	if e.Default != nil {
+		encodeEnvelopeTKey(enc, "default", e.Default)
+	} else {
+		enc.NullKey("default")
+	}
+	if len(e.Previous) > 0 {
+		enc.ArrayKey("previous", gojay.EncodeArrayFunc(func(enc *gojay.Encoder) {
+			for i := range e.Previous {
+				enc.Object(e.Previous[i])
+			}
+		}))
+	}
+}
+
+// IsNil checks if instance is nil
+func (e *Envelope[T, M]) IsNil() bool {
+	return e == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (e *Envelope[T, M]) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "page":
+		if dec.Peek() == gojay.NullKind {
+			e.Page = nil
+			return dec.Skip()
+		}
+		var value Page[T]
+		err := dec.Object(&value)
+		if err == nil {
+			e.Page = &value
+		}
+		return err
+
+	case "meta":
+		return decodeEnvelopeM(dec, &e.Meta)
+
+	case "default":
+		if dec.Peek() == gojay.NullKind {
+			e.Default = nil
+			return dec.Skip()
+		}
+		var value T
+		err := decodeEnvelopeT(dec, &value)
+		if err == nil {
+			e.Default = &value
+		}
+		return err
+
+	case "previous":
+		return dec.Array(gojay.DecodeArrayFunc(func(dec *gojay.Decoder) error {
+			var value Page[T]
+			if err := dec.Object(&value); err != nil {
+				return err
+			}
+			e.Previous = append(e.Previous, &value)
+			return nil
+		}))
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (e *Envelope[T, M]) NKeys() int { return 4 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (i *Item) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.IntKey("id", i.ID)
+	enc.StringKey("name", i.Name)
+}
+
+// IsNil checks if instance is nil
+func (i *Item) IsNil() bool {
+	return i == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (i *Item) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "id":
+		return dec.Int(&i.ID)
+
+	case "name":
+		return dec.String(&i.Name)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (i *Item) NKeys() int { return 2 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (m *Message) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.IntKey("id", m.ID)
+	enc.ObjectKey("items", &m.Items)
+	if m.Counts != nil {
+		enc.ObjectKey("counts", m.Counts)
+	} else {
+		enc.NullKey("counts")
+	}
+	if len(m.Archived) > 0 {
+		enc.ArrayKey("archived", gojay.EncodeArrayFunc(func(enc *gojay.Encoder) {
+			for i := range m.Archived {
+				enc.Object(&m.Archived[i])
+			}
+		}))
+	}
+}
+
+// IsNil checks if instance is nil
+func (m *Message) IsNil() bool {
+	return m == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "id":
+		return dec.Int(&m.ID)
+
+	case "items":
+		return dec.Object(&m.Items)
+
+	case "counts":
+		if dec.Peek() == gojay.NullKind {
+			m.Counts = nil
+			return dec.Skip()
+		}
+		var value Envelope[int, string]
+		err := dec.Object(&value)
+		if err == nil {
+			m.Counts = &value
+		}
+		return err
+
+	case "archived":
+		return dec.Array(gojay.DecodeArrayFunc(func(dec *gojay.Decoder) error {
+			var value Page[Item]
+			if err := dec.Object(&value); err != nil {
+				return err
+			}
+			m.Archived = append(m.Archived, value)
+			return nil
+		}))
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (m *Message) NKeys() int { return 4 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (p *Page[T]) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.ArrayKey("items", gojay.EncodeArrayFunc(func(enc *gojay.Encoder) {
+		for i := range p.Items {
+			encodePageT(enc, &p.Items[i])
+		}
+	}))
+	enc.StringKeyOmitEmpty("next", p.Next)
+}
+
+// IsNil checks if instance is nil
+func (p *Page[T]) IsNil() bool {
+	return p == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (p *Page[T]) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "items":
+		return dec.Array(gojay.DecodeArrayFunc(func(dec *gojay.Decoder) error {
+			var value T
+			if err := decodePageT(dec, &value); err != nil {
+				return err
+			}
+			p.Items = append(p.Items, value)
+			return nil
+		}))
+
+	case "next":
+		return dec.String(&p.Next)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (p *Page[T]) NKeys() int { return 2 }
@@ -0,0 +1,49 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+//go:build go1.18
+
+package generic_struct
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay/gojaytest"
+)
+
+var gojayItemTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Item{} },
+	TagName: "json",
+}
+
+func TestItem_GojayRoundTrip(t *testing.T) {
+	gojayItemTester.RoundTrip(t)
+}
+
+func TestItem_GojayEncodingJSON(t *testing.T) {
+	gojayItemTester.EncodingJSON(t)
+}
+
+func BenchmarkItem_GojayMarshal(b *testing.B) {
+	gojayItemTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkItem_GojayUnmarshal(b *testing.B) {
+	gojayItemTester.BenchmarkUnmarshal(b)
+}
+
+var gojayMessageTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Message{} },
+	TagName: "json",
+}
+
+func TestMessage_GojayRoundTrip(t *testing.T) {
+	gojayMessageTester.RoundTrip(t)
+}
+
+func BenchmarkMessage_GojayMarshal(b *testing.B) {
+	gojayMessageTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkMessage_GojayUnmarshal(b *testing.B) {
+	gojayMessageTester.BenchmarkUnmarshal(b)
+}
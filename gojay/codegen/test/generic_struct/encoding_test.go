@@ -0,0 +1,86 @@
+//go:build go1.18
+
+package generic_struct
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay"
+	"github.com/stretchr/testify/require"
+)
+
+var defaultCount = 7
+
+var msg = &Message{
+	ID: 1,
+	Items: Page[Item]{
+		Items: []Item{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}},
+		Next:  "cursor",
+	},
+	Counts: &Envelope[int, string]{
+		Page:     &Page[int]{Items: []int{1, 2, 3}},
+		Meta:     "counts",
+		Default:  &defaultCount,
+		Previous: []*Page[int]{{Items: []int{4}, Next: "p1"}},
+	},
+	Archived: []Page[Item]{{Items: []Item{{ID: 3, Name: "c"}}}},
+}
+
+var jsonData = `{
+  "id": 1,
+  "items": {"items": [{"id": 1, "name": "a"}, {"id": 2, "name": "b"}], "next": "cursor"},
+  "counts": {
+    "page": {"items": [1, 2, 3]},
+    "meta": "counts",
+    "default": 7,
+    "previous": [{"items": [4], "next": "p1"}]
+  },
+  "archived": [{"items": [{"id": 3, "name": "c"}]}]
+}`
+
+func TestMessage_Unmarshal(t *testing.T) {
+	message := &Message{}
+	err := gojay.UnmarshalJSONObject([]byte(jsonData), message)
+	require.Nil(t, err)
+	require.Equal(t, msg, message)
+}
+
+func TestMessage_Marshal(t *testing.T) {
+	data, err := gojay.MarshalJSONObject(msg)
+	require.Nil(t, err)
+	require.JSONEq(t, jsonData, string(data))
+}
+
+func TestEnvelope_Null(t *testing.T) {
+	envelope := &Envelope[Item, int]{}
+	err := gojay.UnmarshalJSONObject([]byte(`{"page":null,"meta":3,"default":{"id":5,"name":"e"}}`), envelope)
+	require.Nil(t, err)
+	require.Nil(t, envelope.Page)
+	require.Equal(t, 3, envelope.Meta)
+	require.Equal(t, &Item{ID: 5, Name: "e"}, envelope.Default)
+
+	data, err := gojay.MarshalJSONObject(envelope)
+	require.Nil(t, err)
+	require.JSONEq(t, `{"page":null,"meta":3,"default":{"id":5,"name":"e"}}`, string(data))
+}
+
+func TestLevelPage(t *testing.T) {
+	page := &LevelPage{}
+	err := gojay.UnmarshalJSONObject([]byte(`{"items":[1,2]}`), page)
+	require.Nil(t, err)
+	require.Equal(t, []Level{1, 2}, page.Items)
+
+	data, err := gojay.MarshalJSONObject(page)
+	require.Nil(t, err)
+	require.JSONEq(t, `{"items":[1,2]}`, string(data))
+}
+
+func TestEnvelope_UnsupportedTypeArgument(t *testing.T) {
+	envelope := &Envelope[bool, int]{}
+	err := gojay.UnmarshalJSONObject([]byte(`{"default":true}`), envelope)
+	require.EqualError(t, err, "unsupported type bool for type parameter T of Envelope")
+
+	envelope.Default = new(bool)
+	_, err = gojay.MarshalJSONObject(envelope)
+	require.EqualError(t, err, "unsupported type bool for type parameter T of Envelope")
+}
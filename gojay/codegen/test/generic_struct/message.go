@@ -0,0 +1,35 @@
+//go:build go1.18
+
+package generic_struct
+
+type Level uint
+
+type Item struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type Page[T any] struct {
+	Items []T    `json:"items"`
+	Next  string `json:"next,omitempty"`
+}
+
+type Envelope[T any, M any] struct {
+	Page     *Page[T]   `json:"page"`
+	Meta     M          `json:"meta"`
+	Default  *T         `json:"default"`
+	Previous []*Page[T] `json:"previous,omitempty"`
+}
+
+type Message struct {
+	ID       int                    `json:"id"`
+	Items    Page[Item]             `json:"items"`
+	Counts   *Envelope[int, string] `json:"counts"`
+	Archived []Page[Item]           `json:"archived,omitempty"`
+}
+
+// ItemEnvelope instantiates Envelope with an item, type arguments are the ones of the instances in the package
+type ItemEnvelope = Envelope[Item, int]
+
+// LevelPage instantiates Page with a named builtin type
+type LevelPage = Page[Level]
@@ -0,0 +1,197 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package named_struct
+
+import (
+	"github.com/francoispqt/gojay"
+	"sort"
+)
+
+type Statuss []Status
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Statuss) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value string
+	if err := dec.String(&value); err != nil {
+		return err
+	}
+	var named = Status(value)
+	*a = append(*a, named)
+	return nil
+}
+
+// MarshalJSONArray encodes arrays into JSON
+func (a Statuss) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.String(string(item))
+	}
+}
+
+// IsNil checks if array is nil
+func (a Statuss) IsNil() bool {
+	return len(a) == 0
+}
+
+type StatusPriorityMap map[Status]Priority
+
+// UnmarshalJSONObject decodes JSON object members into map
+func (m StatusPriorityMap) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	var value int
+	if err := dec.Int(&value); err != nil {
+		return err
+	}
+	m[Status(k)] = Priority(value)
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal, 0 decodes all keys
+func (m StatusPriorityMap) NKeys() int {
+	return 0
+}
+
+// MarshalJSONObject encodes map into JSON, keys are sorted to get a deterministic output
+func (m StatusPriorityMap) MarshalJSONObject(enc *gojay.Encoder) {
+	var keys = make([]Status, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return string(keys[i]) < string(keys[j]) })
+	for _, k := range keys {
+		var value = m[k]
+		enc.IntKey(string(k), int(value))
+	}
+}
+
+// IsNil checks if map is empty
+func (m StatusPriorityMap) IsNil() bool {
+	return len(m) == 0
+}
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (m *Message) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("status", string(m.Status))
+	if m.StatusPtr != nil {
+		enc.StringKey("statusPtr", string(*m.StatusPtr))
+	}
+	enc.IntKey("priority", int(m.Priority))
+	enc.IntKey("code", int(m.Code))
+	var statusesSlice = Statuss(m.Statuses)
+	enc.ArrayKey("statuses", statusesSlice)
+	if text, err := m.Level.MarshalText(); err != nil {
+		enc.SetError(err)
+	} else {
+		enc.StringKey("level", string(text))
+	}
+	enc.StringKey("color", m.Color.String())
+	if m.ColorPtr != nil {
+		enc.StringKey("colorPtr", m.ColorPtr.String())
+	}
+	if m.ByStatus == nil {
+		enc.NullKey("byStatus")
+	} else {
+		enc.ObjectKey("byStatus", StatusPriorityMap(m.ByStatus))
+	}
+}
+
+// IsNil checks if instance is nil
+func (m *Message) IsNil() bool {
+	return m == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "status":
+		var value string
+		err := dec.String(&value)
+		if err == nil {
+			m.Status = Status(value)
+		}
+		return err
+
+	case "statusPtr":
+		var value string
+		err := dec.String(&value)
+		if err == nil {
+			var named = Status(value)
+			m.StatusPtr = &named
+		}
+		return err
+
+	case "priority":
+		var value int
+		err := dec.Int(&value)
+		if err == nil {
+			m.Priority = Priority(value)
+		}
+		return err
+
+	case "code":
+		var value int
+		err := dec.Int(&value)
+		if err == nil {
+			m.Code = Code(value)
+		}
+		return err
+
+	case "statuses":
+		var aSlice = Statuss{}
+		err := dec.Array(&aSlice)
+		if err == nil && len(aSlice) > 0 {
+			m.Statuses = []Status(aSlice)
+		}
+		return err
+
+	case "level":
+		var text string
+		if err := dec.String(&text); err != nil {
+			return err
+		}
+		var value Level
+		err := value.UnmarshalText([]byte(text))
+		if err == nil {
+			m.Level = value
+		}
+		return err
+
+	case "color":
+		var text string
+		if err := dec.String(&text); err != nil {
+			return err
+		}
+		value, err := ParseColor(text)
+		if err == nil {
+			m.Color = value
+		}
+		return err
+
+	case "colorPtr":
+		var text string
+		if err := dec.String(&text); err != nil {
+			return err
+		}
+		value, err := ParseColor(text)
+		if err == nil {
+			m.ColorPtr = &value
+		}
+		return err
+
+	case "byStatus":
+		if dec.Peek() == gojay.NullKind {
+			m.ByStatus = nil
+			return dec.Skip()
+		}
+		var aMap = StatusPriorityMap{}
+		err := dec.Object(aMap)
+		if err == nil {
+			m.ByStatus = map[Status]Priority(aMap)
+		}
+		return err
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (m *Message) NKeys() int { return 9 }
@@ -0,0 +1,59 @@
+package named_struct
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay"
+	"github.com/stretchr/testify/require"
+)
+
+var statusPtr = Status("pending")
+var colorPtr = Green
+
+var msg = &Message{
+	Status:    "active",
+	StatusPtr: &statusPtr,
+	Priority:  3,
+	Code:      200,
+	Statuses:  []Status{"a", "b"},
+	Level:     2,
+	Color:     Green,
+	ColorPtr:  &colorPtr,
+	ByStatus:  map[Status]Priority{"low": 1, "high": 5},
+}
+
+var jsonData = `{
+  "status": "active",
+  "statusPtr": "pending",
+  "priority": 3,
+  "code": 200,
+  "statuses": ["a", "b"],
+  "level": "++",
+  "color": "green",
+  "colorPtr": "green",
+  "byStatus": {"high": 5, "low": 1}
+}`
+
+func TestMessage_Unmarshal(t *testing.T) {
+	message := &Message{}
+	err := gojay.UnmarshalJSONObject([]byte(jsonData), message)
+	require.Nil(t, err)
+	require.Equal(t, msg, message)
+}
+
+func TestMessage_Marshal(t *testing.T) {
+	data, err := gojay.MarshalJSONObject(msg)
+	require.Nil(t, err)
+	require.JSONEq(t, jsonData, string(data))
+}
+
+func TestMessage_UnmarshalInvalidEnum(t *testing.T) {
+	message := &Message{}
+	err := gojay.UnmarshalJSONObject([]byte(`{"color":"blue"}`), message)
+	require.NotNil(t, err)
+}
+
+func TestMessage_MarshalInvalidEnum(t *testing.T) {
+	_, err := gojay.MarshalJSONObject(&Message{Level: -1})
+	require.EqualError(t, err, "invalid level -1")
+}
@@ -0,0 +1,13 @@
+package named_struct
+
+type Message struct {
+	Status    Status              `json:"status"`
+	StatusPtr *Status             `json:"statusPtr"`
+	Priority  Priority            `json:"priority"`
+	Code      Code                `json:"code"`
+	Statuses  []Status            `json:"statuses"`
+	Level     Level               `json:"level" gojay:"enum=text"`
+	Color     Color               `json:"color" gojay:"enum=string;parse=ParseColor"`
+	ColorPtr  *Color              `json:"colorPtr" gojay:"enum=string;parse=ParseColor"`
+	ByStatus  map[Status]Priority `json:"byStatus"`
+}
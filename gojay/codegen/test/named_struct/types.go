@@ -0,0 +1,50 @@
+package named_struct
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Status string
+
+type Priority int
+
+type Code = int
+
+type Level int
+
+func (l Level) MarshalText() ([]byte, error) {
+	if l < 0 {
+		return nil, fmt.Errorf("invalid level %d", int(l))
+	}
+	return []byte(strings.Repeat("+", int(l))), nil
+}
+
+func (l *Level) UnmarshalText(text []byte) error {
+	*l = Level(len(text))
+	return nil
+}
+
+type Color int
+
+const (
+	Red Color = iota
+	Green
+)
+
+func (c Color) String() string {
+	if c == Green {
+		return "green"
+	}
+	return "red"
+}
+
+func ParseColor(text string) (Color, error) {
+	switch text {
+	case "red":
+		return Red, nil
+	case "green":
+		return Green, nil
+	}
+	return Red, fmt.Errorf("invalid color %q", text)
+}
@@ -0,0 +1,194 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package presence_struct
+
+import (
+	"github.com/francoispqt/gojay"
+)
+
+type Strings []string
+
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Strings) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value string
+	if err := dec.String(&value); err != nil {
+		return err
+	}
+	*a = append(*a, value)
+	return nil
+}
+
+// MarshalJSONArray encodes arrays into JSON
+func (a Strings) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.String(item)
+	}
+}
+
+// IsNil checks if array is nil
+func (a Strings) IsNil() bool {
+	return len(a) == 0
+}
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (a *Address) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("city", a.City)
+	enc.StringKey("zip", *a.Zip)
+}
+
+// IsNil checks if instance is nil
+func (a *Address) IsNil() bool {
+	return a == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (a *Address) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "city":
+		if a.fields.Has(0) {
+			defer dec.DuplicateKey()
+		}
+		a.fields.Set(0)
+		return dec.String(&a.City)
+
+	case "zip":
+		if a.fields.Has(1) {
+			defer dec.DuplicateKey()
+		}
+		a.fields.Set(1)
+		var value string
+		err := dec.String(&value)
+		if err == nil {
+			a.Zip = &value
+		}
+		return err
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal, 0 decodes all keys
+func (a *Address) NKeys() int { return 0 }
+
+// FieldsSet returns the fields present in the decoded JSON, identified by their index in the JSON fields
+func (a *Address) FieldsSet() gojay.FieldSet {
+	return a.fields
+}
+
+// HasCity returns true if the city key was present in the decoded JSON
+func (a *Address) HasCity() bool {
+	return a.fields.Has(0)
+}
+
+// HasZip returns true if the zip key was present in the decoded JSON
+func (a *Address) HasZip() bool {
+	return a.fields.Has(1)
+}
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (p *Patch) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("name", p.Name)
+	enc.IntKey("age", p.Age)
+	enc.StringKeyOmitEmpty("email", *p.Email)
+	var tagsSlice = Strings(p.Tags)
+	enc.ArrayKey("tags", tagsSlice)
+	enc.ObjectKey("address", p.Address)
+}
+
+// IsNil checks if instance is nil
+func (p *Patch) IsNil() bool {
+	return p == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (p *Patch) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "name":
+		if p.fields.Has(0) {
+			defer dec.DuplicateKey()
+		}
+		p.fields.Set(0)
+		return dec.String(&p.Name)
+
+	case "age":
+		if p.fields.Has(1) {
+			defer dec.DuplicateKey()
+		}
+		p.fields.Set(1)
+		return dec.Int(&p.Age)
+
+	case "email":
+		if p.fields.Has(2) {
+			defer dec.DuplicateKey()
+		}
+		p.fields.Set(2)
+		var value string
+		err := dec.String(&value)
+		if err == nil {
+			p.Email = &value
+		}
+		return err
+
+	case "tags":
+		if p.fields.Has(3) {
+			defer dec.DuplicateKey()
+		}
+		p.fields.Set(3)
+		var aSlice = Strings{}
+		err := dec.Array(&aSlice)
+		if err == nil && len(aSlice) > 0 {
+			p.Tags = []string(aSlice)
+		}
+		return err
+
+	case "address":
+		if p.fields.Has(4) {
+			defer dec.DuplicateKey()
+		}
+		p.fields.Set(4)
+		var value = &Address{}
+		err := dec.Object(value)
+		if err == nil {
+			p.Address = value
+		}
+
+		return err
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal, 0 decodes all keys
+func (p *Patch) NKeys() int { return 0 }
+
+// FieldsSet returns the fields present in the decoded JSON, identified by their index in the JSON fields
+func (p *Patch) FieldsSet() gojay.FieldSet {
+	return p.fields
+}
+
+// HasName returns true if the name key was present in the decoded JSON
+func (p *Patch) HasName() bool {
+	return p.fields.Has(0)
+}
+
+// HasAge returns true if the age key was present in the decoded JSON
+func (p *Patch) HasAge() bool {
+	return p.fields.Has(1)
+}
+
+// HasEmail returns true if the email key was present in the decoded JSON
+func (p *Patch) HasEmail() bool {
+	return p.fields.Has(2)
+}
+
+// HasTags returns true if the tags key was present in the decoded JSON
+func (p *Patch) HasTags() bool {
+	return p.fields.Has(3)
+}
+
+// HasAddress returns true if the address key was present in the decoded JSON
+func (p *Patch) HasAddress() bool {
+	return p.fields.Has(4)
+}
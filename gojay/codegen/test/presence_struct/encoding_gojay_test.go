@@ -0,0 +1,51 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package presence_struct
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay/gojaytest"
+)
+
+var gojayAddressTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Address{} },
+	TagName: "json",
+}
+
+func TestAddress_GojayRoundTrip(t *testing.T) {
+	gojayAddressTester.RoundTrip(t)
+}
+
+func TestAddress_GojayEncodingJSON(t *testing.T) {
+	gojayAddressTester.EncodingJSON(t)
+}
+
+func BenchmarkAddress_GojayMarshal(b *testing.B) {
+	gojayAddressTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkAddress_GojayUnmarshal(b *testing.B) {
+	gojayAddressTester.BenchmarkUnmarshal(b)
+}
+
+var gojayPatchTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Patch{} },
+	TagName: "json",
+}
+
+func TestPatch_GojayRoundTrip(t *testing.T) {
+	gojayPatchTester.RoundTrip(t)
+}
+
+func TestPatch_GojayEncodingJSON(t *testing.T) {
+	gojayPatchTester.EncodingJSON(t)
+}
+
+func BenchmarkPatch_GojayMarshal(b *testing.B) {
+	gojayPatchTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkPatch_GojayUnmarshal(b *testing.B) {
+	gojayPatchTester.BenchmarkUnmarshal(b)
+}
@@ -0,0 +1,39 @@
+package presence_struct
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatch_Presence(t *testing.T) {
+	patch := &Patch{}
+	require.Nil(t, gojay.UnmarshalJSONObject([]byte(`{"name":"","address":{"zip":"75001"}}`), patch))
+	require.True(t, patch.HasName(), "name is present with a zero value")
+	require.False(t, patch.HasAge(), "age is absent")
+	require.False(t, patch.HasEmail(), "email is absent")
+	require.True(t, patch.HasAddress(), "address is present")
+	require.Equal(t, 2, patch.FieldsSet().Len())
+	require.False(t, patch.Address.HasCity(), "address city is absent")
+	require.True(t, patch.Address.HasZip(), "address zip is present")
+}
+
+func TestPatch_DuplicateKeys(t *testing.T) {
+	for _, input := range []string{
+		`{"name":"a","name":"b","age":0,"email":"c","tags":["d"],"address":{"city":"e"}}`,
+		`{"name":"a","age":1,"age":0,"age":0,"tags":[],"name":"b","email":"c","tags":["d"],"address":{"city":"x","city":"e"}}`,
+	} {
+		patch := &Patch{}
+		require.Nil(t, gojay.UnmarshalJSONObject([]byte(input), patch))
+		require.Equal(t, "b", patch.Name, input)
+		require.Equal(t, 0, patch.Age, input)
+		require.True(t, patch.HasAge(), input)
+		require.NotNil(t, patch.Email, input)
+		require.Equal(t, "c", *patch.Email, input)
+		require.Equal(t, []string{"d"}, patch.Tags, input)
+		require.NotNil(t, patch.Address, input)
+		require.Equal(t, "e", patch.Address.City, input)
+		require.Equal(t, 5, patch.FieldsSet().Len(), input)
+	}
+}
@@ -0,0 +1,18 @@
+package presence_struct
+
+import "github.com/francoispqt/gojay"
+
+type Address struct {
+	City   string  `json:"city"`
+	Zip    *string `json:"zip"`
+	fields gojay.FieldSet
+}
+
+type Patch struct {
+	Name    string   `json:"name"`
+	Age     int      `json:"age"`
+	Email   *string  `json:"email,omitempty"`
+	Tags    []string `json:"tags"`
+	Address *Address `json:"address"`
+	fields  gojay.FieldSet
+}
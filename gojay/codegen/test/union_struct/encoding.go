@@ -0,0 +1,173 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package union_struct
+
+import (
+	"fmt"
+	"github.com/francoispqt/gojay"
+)
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (c *Circle) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.Float64Key("radius", c.Radius)
+}
+
+// IsNil checks if instance is nil
+func (c *Circle) IsNil() bool {
+	return c == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (c *Circle) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "radius":
+		return dec.Float64(&c.Radius)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (c *Circle) NKeys() int { return 1 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (m *Message) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.IntKey("id", m.Id)
+	switch value := m.Shape.(type) {
+	case *Circle:
+		enc.ObjectKey("shape", gojay.EncodeObjectFunc(func(enc *gojay.Encoder) {
+			enc.StringKey("type", "circle")
+			value.MarshalJSONObject(enc)
+		}))
+	case *Square:
+		enc.ObjectKey("shape", gojay.EncodeObjectFunc(func(enc *gojay.Encoder) {
+			enc.StringKey("type", "square")
+			value.MarshalJSONObject(enc)
+		}))
+	case nil:
+		enc.NullKey("shape")
+	default:
+		enc.SetError(fmt.Errorf("unsupported shape type %T", value))
+	}
+	switch value := m.Backup.(type) {
+	case *Circle:
+		enc.ObjectKey("backup", gojay.EncodeObjectFunc(func(enc *gojay.Encoder) {
+			enc.StringKey("kind", "circle")
+			value.MarshalJSONObject(enc)
+		}))
+	case *Square:
+		enc.ObjectKey("backup", gojay.EncodeObjectFunc(func(enc *gojay.Encoder) {
+			enc.StringKey("kind", "square")
+			value.MarshalJSONObject(enc)
+		}))
+	case nil:
+	default:
+		enc.SetError(fmt.Errorf("unsupported backup type %T", value))
+	}
+	enc.StringKey("name", m.Name)
+}
+
+// IsNil checks if instance is nil
+func (m *Message) IsNil() bool {
+	return m == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "id":
+		return dec.Int(&m.Id)
+
+	case "shape":
+		if dec.Peek() == gojay.NullKind {
+			m.Shape = nil
+			return dec.Skip()
+		}
+		var value = gojay.EmbeddedJSON{}
+		if err := dec.AddEmbeddedJSON(&value); err != nil {
+			return err
+		}
+		discriminator, err := gojay.GetString(value, "/type")
+		if err != nil {
+			return err
+		}
+		switch discriminator {
+		case "circle":
+			var item = &Circle{}
+			if err = gojay.UnmarshalJSONObject(value, item); err == nil {
+				m.Shape = item
+			}
+			return err
+		case "square":
+			var item = &Square{}
+			if err = gojay.UnmarshalJSONObject(value, item); err == nil {
+				m.Shape = item
+			}
+			return err
+		}
+		return fmt.Errorf("unknown shape type: %q", discriminator)
+
+	case "backup":
+		if dec.Peek() == gojay.NullKind {
+			m.Backup = nil
+			return dec.Skip()
+		}
+		var value = gojay.EmbeddedJSON{}
+		if err := dec.AddEmbeddedJSON(&value); err != nil {
+			return err
+		}
+		discriminator, err := gojay.GetString(value, "/kind")
+		if err != nil {
+			return err
+		}
+		switch discriminator {
+		case "circle":
+			var item = &Circle{}
+			if err = gojay.UnmarshalJSONObject(value, item); err == nil {
+				m.Backup = item
+			}
+			return err
+		case "square":
+			var item = &Square{}
+			if err = gojay.UnmarshalJSONObject(value, item); err == nil {
+				m.Backup = item
+			}
+			return err
+		}
+		return fmt.Errorf("unknown backup kind: %q", discriminator)
+
+	case "name":
+		return dec.String(&m.Name)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (m *Message) NKeys() int { return 4 }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (s *Square) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.Float64Key("side", s.Side)
+}
+
+// IsNil checks if instance is nil
+func (s *Square) IsNil() bool {
+	return s == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (s *Square) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+	case "side":
+		return dec.Float64(&s.Side)
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (s *Square) NKeys() int { return 1 }
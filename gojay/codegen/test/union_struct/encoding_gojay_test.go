@@ -0,0 +1,68 @@
+// Code generated by Gojay. DO NOT EDIT.
+
+package union_struct
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay/gojaytest"
+)
+
+var gojayCircleTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Circle{} },
+	TagName: "json",
+}
+
+func TestCircle_GojayRoundTrip(t *testing.T) {
+	gojayCircleTester.RoundTrip(t)
+}
+
+func TestCircle_GojayEncodingJSON(t *testing.T) {
+	gojayCircleTester.EncodingJSON(t)
+}
+
+func BenchmarkCircle_GojayMarshal(b *testing.B) {
+	gojayCircleTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkCircle_GojayUnmarshal(b *testing.B) {
+	gojayCircleTester.BenchmarkUnmarshal(b)
+}
+
+var gojayMessageTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Message{} },
+	TagName: "json",
+}
+
+func TestMessage_GojayRoundTrip(t *testing.T) {
+	gojayMessageTester.RoundTrip(t)
+}
+
+func BenchmarkMessage_GojayMarshal(b *testing.B) {
+	gojayMessageTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkMessage_GojayUnmarshal(b *testing.B) {
+	gojayMessageTester.BenchmarkUnmarshal(b)
+}
+
+var gojaySquareTester = &gojaytest.Tester{
+	New:     func() gojaytest.Object { return &Square{} },
+	TagName: "json",
+}
+
+func TestSquare_GojayRoundTrip(t *testing.T) {
+	gojaySquareTester.RoundTrip(t)
+}
+
+func TestSquare_GojayEncodingJSON(t *testing.T) {
+	gojaySquareTester.EncodingJSON(t)
+}
+
+func BenchmarkSquare_GojayMarshal(b *testing.B) {
+	gojaySquareTester.BenchmarkMarshal(b)
+}
+
+func BenchmarkSquare_GojayUnmarshal(b *testing.B) {
+	gojaySquareTester.BenchmarkUnmarshal(b)
+}
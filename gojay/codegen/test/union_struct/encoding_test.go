@@ -0,0 +1,70 @@
+package union_struct
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay"
+	"github.com/stretchr/testify/require"
+)
+
+var jsonData = `{
+  "id": 1,
+  "shape": {"type": "square", "side": 2},
+  "backup": {"radius": 1.5, "kind": "circle"},
+  "name": "union"
+}`
+
+var msg = &Message{
+	Id:     1,
+	Shape:  &Square{Side: 2},
+	Backup: &Circle{Radius: 1.5},
+	Name:   "union",
+}
+
+func TestMessage_Unmarshal(t *testing.T) {
+	message := &Message{}
+	err := gojay.UnmarshalJSONObject([]byte(jsonData), message)
+	require.Nil(t, err)
+	require.Equal(t, msg, message)
+}
+
+func TestMessage_Marshal(t *testing.T) {
+	data, err := gojay.MarshalJSONObject(msg)
+	require.Nil(t, err)
+	require.JSONEq(t, jsonData, string(data))
+	require.Contains(t, string(data), `"shape":{"type":"square",`)
+}
+
+func TestMessage_NilShape(t *testing.T) {
+	data, err := gojay.MarshalJSONObject(&Message{Id: 2})
+	require.Nil(t, err)
+	require.JSONEq(t, `{"id":2,"shape":null,"name":""}`, string(data))
+
+	message := &Message{Shape: &Circle{}}
+	err = gojay.UnmarshalJSONObject(data, message)
+	require.Nil(t, err)
+	require.Nil(t, message.Shape)
+}
+
+func TestMessage_UnknownDiscriminator(t *testing.T) {
+	message := &Message{}
+	err := gojay.UnmarshalJSONObject([]byte(`{"shape":{"type":"triangle"}}`), message)
+	require.NotNil(t, err)
+	err = gojay.UnmarshalJSONObject([]byte(`{"shape":{"side":1}}`), message)
+	require.Equal(t, gojay.ErrPointerNotFound, err)
+}
+
+type triangle struct{}
+
+func (triangle) Area() float64 {
+	return 0
+}
+
+func TestMessage_MarshalUnsupportedShape(t *testing.T) {
+	_, err := gojay.MarshalJSONObject(&Message{Shape: triangle{}})
+	require.EqualError(t, err, "unsupported shape type union_struct.triangle")
+	// an omitted nil union is not an error
+	data, err := gojay.MarshalJSONObject(&Message{Shape: &Circle{}})
+	require.Nil(t, err)
+	require.NotContains(t, string(data), `"backup"`)
+}
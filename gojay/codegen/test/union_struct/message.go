@@ -0,0 +1,8 @@
+package union_struct
+
+type Message struct {
+	Id     int    `json:"id"`
+	Shape  Shape  `json:"shape" gojay:"discriminator=type;Circle=circle;Square=square"`
+	Backup Shape  `json:"backup,omitempty" gojay:"discriminator=kind;Circle=circle;Square=square"`
+	Name   string `json:"name"`
+}
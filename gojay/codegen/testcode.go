@@ -0,0 +1,89 @@
+package codegen
+
+import (
+	"go/format"
+	"strings"
+)
+
+const generatedTestFileSuffix = "_gojay_test.go"
+
+// testedType represents a struct type with generated tests
+type testedType struct {
+	Name         string
+	TestName     string //exported form of the name, as test function names can not start with a lower case letter
+	EncodingJSON bool   //type is encoded as by encoding/json
+}
+
+// trackEncodingJSON records whether a field is encoded as by encoding/json and the struct types it references
+func (s *Struct) trackEncodingJSON(templateKey int, field *Field) {
+	switch templateKey {
+	case encodeRawType, encodeSQLNull, encodeUnion, encodeEnumText, encodeEnumString, encodeCodec, encodeGeneric, encodeGenericSlice:
+		s.jsonIncompatible[s.Name] = true
+	case encodeTime:
+		if field.TimeLayout != "time.RFC3339" {
+			s.jsonIncompatible[s.Name] = true
+		}
+	}
+	if strings.Contains(field.RawType, "[]byte") {
+		// byte slices nested in composite types hold raw JSON, as []byte fields do
+		s.jsonIncompatible[s.Name] = true
+	}
+	for _, typeName := range []string{field.Type, field.ComponentType, strings.TrimPrefix(normalizeTypeName(field.RawType), "map["), elementType(field.RawType)} {
+		if typeInfo := s.Type(normalizeTypeName(typeName)); typeInfo != nil {
+			s.references[s.Name] = append(s.references[s.Name], typeInfo.Name)
+		}
+	}
+}
+
+// isEncodingJSON returns true if a struct type and the struct types it references are encoded as by encoding/json
+func (g *Generator) isEncodingJSON(typeName string, visited map[string]bool) bool {
+	if visited[typeName] {
+		return true
+	}
+	visited[typeName] = true
+	if g.jsonIncompatible[typeName] {
+		return false
+	}
+	for _, reference := range g.references[typeName] {
+		if !g.isEncodingJSON(reference, visited) {
+			return false
+		}
+	}
+	return true
+}
+
+// generateTestCode generates the tests of the struct types declared in the source files matched by hasFile, nil if there are none.
+// It must follow generateCode, whose build tag is used.
+func (g *Generator) generateTestCode(hasFile func(file string) bool) ([]byte, error) {
+	var types = []*testedType{}
+	for _, key := range sortedKeys(g.structTypes) {
+		// generic types can not be tested without type arguments
+		if !hasFile(g.typeFile(key)) || g.generics[key] != nil {
+			continue
+		}
+		types = append(types, &testedType{
+			Name:         key,
+			TestName:     firstLetterToUppercase(key),
+			EncodingJSON: g.options.TagName == "json" && g.isEncodingJSON(key, map[string]bool{}),
+		})
+	}
+	if len(types) == 0 {
+		return nil, nil
+	}
+	code, err := expandBlockTemplate(testFileCode, struct {
+		Pkg      string
+		BuildTag string
+		TagName  string
+		Types    []*testedType
+	}{g.Pkg, g.BuildTag, g.options.TagName, types})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source([]byte(code))
+}
+
+// testFileName returns the name of the test file of a generated file, i.e message_gojay_test.go for message_gojay.go
+func testFileName(filename string) string {
+	filename = strings.TrimSuffix(filename, ".go")
+	return strings.TrimSuffix(filename, strings.TrimSuffix(generatedFileSuffix, ".go")) + generatedTestFileSuffix
+}
@@ -8,10 +8,12 @@ import (
 
 var pkg = flag.String("pkg", "", "the package name of the generated file")
 var dst = flag.String("o", "", "destination file to output generated code")
-var src = flag.String("s", "", "source dir or file (absolute or relative path)")
-var types = flag.String("t", "", "types to generate")
+var src = flag.String("s", "", "source dirs or file (comma separated, absolute or relative path, dir/... for all dirs below)")
+var types = flag.String("t", "", "types to generate, types annotated with //gojay:generate if empty")
 var annotation = flag.String("a", "json", "annotation tag (default json)")
 var poolObjects = flag.String("p", "", "generate code to reuse objects using sync.Pool")
+var check = flag.Bool("check", false, "exit with an error if generated code is out of date instead of writing it")
+var tests = flag.Bool("tests", false, "write _gojay_test.go files with round trip tests and benchmarks of the generated types")
 
 func main() {
 	flag.Parse()
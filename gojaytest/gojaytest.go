@@ -0,0 +1,345 @@
+// Package gojaytest tests the code written for gojay, it is used by the tests emitted by the code generator.
+//
+// Values are populated with random non zero values, encoded and decoded back with gojay,
+// and compared with the output of encoding/json.
+package gojaytest
+
+import (
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/francoispqt/gojay"
+)
+
+const (
+	defaultSeed       = 1
+	defaultIterations = 20
+	maxDepth          = 3
+	letters           = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	fieldSetType = reflect.TypeOf(gojay.FieldSet(0))
+)
+
+// Object is implemented by the types encoded and decoded as JSON objects.
+type Object interface {
+	gojay.MarshalerJSONObject
+	gojay.UnmarshalerJSONObject
+}
+
+// Tester tests the gojay code of a type.
+type Tester struct {
+	// New returns a pointer to a new zero value of the type.
+	New func() Object
+	// TagName is the struct tag holding the JSON keys, fields tagged "-" are not populated.
+	TagName string
+	// Seed of the random values, 1 if zero.
+	Seed int64
+	// Iterations is the number of random values tested, 20 if zero.
+	Iterations int
+}
+
+func (tr *Tester) seed() int64 {
+	if tr.Seed == 0 {
+		return defaultSeed
+	}
+	return tr.Seed
+}
+
+func (tr *Tester) iterations() int {
+	if tr.Iterations == 0 {
+		return defaultIterations
+	}
+	return tr.Iterations
+}
+
+// populate returns a random value, with the json tag name the fields encoding/json does not see,
+// i.e. the conflicting fields of embedded structs, are reset by a round trip through encoding/json.
+func (tr *Tester) populate(r *rand.Rand) Object {
+	value := tr.New()
+	Populate(value, r, tr.TagName)
+	if tr.TagName != "json" {
+		return value
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	visible := tr.New()
+	if err = json.Unmarshal(data, visible); err != nil {
+		return value
+	}
+	return visible
+}
+
+// RoundTrip checks that random values are equal once marshaled and unmarshaled with gojay.
+func (tr *Tester) RoundTrip(t testing.TB) {
+	t.Helper()
+	r := rand.New(rand.NewSource(tr.seed()))
+	for i := 0; i < tr.iterations(); i++ {
+		value := tr.populate(r)
+		data, err := gojay.MarshalJSONObject(value)
+		if err != nil {
+			t.Fatalf("failed to marshal %T: %v", value, err)
+		}
+		decoded := tr.New()
+		if err = gojay.UnmarshalJSONObject(data, decoded); err != nil {
+			t.Fatalf("failed to unmarshal %T from %s: %v", value, data, err)
+		}
+		if !Equal(value, decoded) {
+			t.Fatalf("%T differs after a round trip through %s\nexpected: %+v\nactual:   %+v", value, data, value, decoded)
+		}
+	}
+}
+
+// EncodingJSON checks that gojay and encoding/json marshal random values to the same JSON,
+// and that gojay unmarshals the output of encoding/json to the original value.
+func (tr *Tester) EncodingJSON(t testing.TB) {
+	t.Helper()
+	r := rand.New(rand.NewSource(tr.seed()))
+	for i := 0; i < tr.iterations(); i++ {
+		value := tr.populate(r)
+		expected, err := json.Marshal(value)
+		if err != nil {
+			t.Fatalf("encoding/json failed to marshal %T: %v", value, err)
+		}
+		actual, err := gojay.MarshalJSONObject(value)
+		if err != nil {
+			t.Fatalf("failed to marshal %T: %v", value, err)
+		}
+		if !EqualJSON(expected, actual) {
+			t.Fatalf("%T is marshaled differently\nencoding/json: %s\ngojay:         %s", value, expected, actual)
+		}
+		decoded := tr.New()
+		if err = gojay.UnmarshalJSONObject(expected, decoded); err != nil {
+			t.Fatalf("failed to unmarshal %T from %s: %v", value, expected, err)
+		}
+		if !Equal(value, decoded) {
+			t.Fatalf("%T differs once unmarshaled from %s\nexpected: %+v\nactual:   %+v", value, expected, value, decoded)
+		}
+	}
+}
+
+// BenchmarkMarshal benchmarks marshaling a random value with gojay.
+func (tr *Tester) BenchmarkMarshal(b *testing.B) {
+	value := tr.populate(rand.New(rand.NewSource(tr.seed())))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := gojay.MarshalJSONObject(value); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkUnmarshal benchmarks unmarshaling a random value with gojay.
+func (tr *Tester) BenchmarkUnmarshal(b *testing.B) {
+	value := tr.populate(rand.New(rand.NewSource(tr.seed())))
+	data, err := gojay.MarshalJSONObject(value)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err = gojay.UnmarshalJSONObject(data, tr.New()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// EqualJSON returns true if a and b hold the same JSON value, regardless of formatting and key order.
+func EqualJSON(a, b []byte) bool {
+	var aValue, bValue interface{}
+	if err := json.Unmarshal(a, &aValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &bValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+// Equal returns true if a and b are deeply equal, regardless of their gojay.FieldSet fields,
+// which record the fields of decoded values only.
+func Equal(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	return equal(reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+func equal(a, b reflect.Value) bool {
+	if a.IsValid() != b.IsValid() {
+		return false
+	}
+	if !a.IsValid() || a.Type() == fieldSetType {
+		return true
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return equal(a.Elem(), b.Elem())
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !equal(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice:
+		if a.IsNil() != b.IsNil() {
+			return false
+		}
+		fallthrough
+	case reflect.Array:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !equal(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.IsNil() != b.IsNil() || a.Len() != b.Len() {
+			return false
+		}
+		for _, key := range a.MapKeys() {
+			if !equal(a.MapIndex(key), b.MapIndex(key)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	case reflect.String:
+		return a.String() == b.String()
+	}
+	return false
+}
+
+// Populate sets the exported fields of the struct pointed by v to random non zero values.
+//
+// Slices and maps get one to three elements, byte slices hold a JSON number as they are encoded as raw JSON.
+// Times are UTC with a second precision. Nested structs are populated up to a depth of 3,
+// deeper struct pointers, slices and maps are left nil.
+// Fields tagged "-" with tagName, gojay.FieldSet fields, interfaces, enums and fields with a codec are left to their zero value,
+// as a random value may not be valid.
+func Populate(v interface{}, r *rand.Rand, tagName string) {
+	populate(reflect.ValueOf(v).Elem(), r, tagName, 0)
+}
+
+func populate(v reflect.Value, r *rand.Rand, tagName string, depth int) {
+	if v.Type() == timeType {
+		v.Set(reflect.ValueOf(time.Unix(r.Int63n(1<<31), 0).UTC()))
+		return
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(r.Int63n(100) + 1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(r.Int63n(100) + 1))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(float64(r.Intn(10000)+1) / 100)
+	case reflect.String:
+		var text = make([]byte, r.Intn(8)+1)
+		for i := range text {
+			text[i] = letters[r.Intn(len(letters))]
+		}
+		v.SetString(string(text))
+	case reflect.Ptr:
+		if depth >= maxDepth && isComposite(v.Type().Elem()) {
+			return
+		}
+		value := reflect.New(v.Type().Elem())
+		populate(value.Elem(), r, tagName, depth)
+		v.Set(value)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte(strconv.Itoa(r.Intn(100) + 1)))
+			return
+		}
+		if depth >= maxDepth {
+			return
+		}
+		slice := reflect.MakeSlice(v.Type(), r.Intn(3)+1, 3)
+		for i := 0; i < slice.Len(); i++ {
+			populate(slice.Index(i), r, tagName, depth)
+		}
+		v.Set(slice)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			populate(v.Index(i), r, tagName, depth)
+		}
+	case reflect.Map:
+		if depth >= maxDepth {
+			return
+		}
+		aMap := reflect.MakeMap(v.Type())
+		for i := r.Intn(3) + 1; i > 0; i-- {
+			key := reflect.New(v.Type().Key()).Elem()
+			populate(key, r, tagName, depth)
+			value := reflect.New(v.Type().Elem()).Elem()
+			populate(value, r, tagName, depth)
+			aMap.SetMapIndex(key, value)
+		}
+		v.Set(aMap)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !v.Field(i).CanSet() || !isPopulated(field, tagName) {
+				continue
+			}
+			populate(v.Field(i), r, tagName, depth+1)
+		}
+	}
+}
+
+// isPopulated returns false for the fields which can not be given a random value
+func isPopulated(field reflect.StructField, tagName string) bool {
+	if field.Type == fieldSetType {
+		return false
+	}
+	if tagName != "" && strings.Split(field.Tag.Get(tagName), ",")[0] == "-" {
+		return false
+	}
+	for _, option := range strings.Split(field.Tag.Get("gojay"), ";") {
+		if strings.HasPrefix(option, "enum=") || strings.HasPrefix(option, "codec=") {
+			return false
+		}
+	}
+	return true
+}
+
+func isComposite(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Struct:
+		return t != timeType
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,178 @@
+package gojaytest
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/francoispqt/gojay"
+	"github.com/stretchr/testify/assert"
+)
+
+type testItem struct {
+	Name  string            `json:"name"`
+	Count *int              `json:"count"`
+	Tags  []string          `json:"tags"`
+	Attrs map[string]int    `json:"attrs"`
+	Skip  string            `json:"-"`
+	Kind  int               `json:"kind" gojay:"enum=kinds"`
+	At    time.Time         `json:"at"`
+	Next  *testItem         `json:"next"`
+	Extra map[int]*testItem `json:"-"`
+}
+
+func (i *testItem) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("name", i.Name)
+	enc.IntKey("count", *i.Count)
+	if len(i.Tags) > 0 {
+		enc.ArrayKey("tags", gojay.EncodeArrayFunc(func(enc *gojay.Encoder) {
+			for _, tag := range i.Tags {
+				enc.String(tag)
+			}
+		}))
+	} else {
+		enc.NullKey("tags")
+	}
+	if len(i.Attrs) > 0 {
+		enc.ObjectKey("attrs", gojay.EncodeObjectFunc(func(enc *gojay.Encoder) {
+			for k, v := range i.Attrs {
+				enc.IntKey(k, v)
+			}
+		}))
+	} else {
+		enc.NullKey("attrs")
+	}
+	enc.IntKey("kind", i.Kind)
+	enc.TimeKey("at", &i.At, time.RFC3339Nano)
+	if i.Next != nil {
+		enc.ObjectKey("next", i.Next)
+	} else {
+		enc.NullKey("next")
+	}
+}
+
+func (i *testItem) IsNil() bool {
+	return i == nil
+}
+
+func (i *testItem) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	switch k {
+	case "name":
+		return dec.String(&i.Name)
+	case "count":
+		return dec.IntNull(&i.Count)
+	case "tags":
+		if dec.Peek() == gojay.NullKind {
+			return dec.Skip()
+		}
+		return dec.Array(gojay.DecodeArrayFunc(func(dec *gojay.Decoder) error {
+			var tag string
+			if err := dec.String(&tag); err != nil {
+				return err
+			}
+			i.Tags = append(i.Tags, tag)
+			return nil
+		}))
+	case "attrs":
+		if dec.Peek() == gojay.NullKind {
+			return dec.Skip()
+		}
+		i.Attrs = map[string]int{}
+		return dec.Object(gojay.DecodeObjectFunc(func(dec *gojay.Decoder, k string) error {
+			var v int
+			if err := dec.Int(&v); err != nil {
+				return err
+			}
+			i.Attrs[k] = v
+			return nil
+		}))
+	case "kind":
+		return dec.Int(&i.Kind)
+	case "at":
+		return dec.Time(&i.At, time.RFC3339Nano)
+	case "next":
+		if dec.Peek() == gojay.NullKind {
+			return dec.Skip()
+		}
+		i.Next = &testItem{}
+		return dec.Object(i.Next)
+	}
+	return nil
+}
+
+func (i *testItem) NKeys() int {
+	return 0
+}
+
+func TestPopulate(t *testing.T) {
+	var item = &testItem{}
+	Populate(item, rand.New(rand.NewSource(1)), "json")
+	assert.NotEmpty(t, item.Name, "name should be populated")
+	assert.NotNil(t, item.Count, "count should be allocated")
+	assert.NotEmpty(t, item.Tags, "tags should be populated")
+	assert.NotEmpty(t, item.Attrs, "attrs should be populated")
+	assert.Empty(t, item.Skip, "fields tagged - should be skipped")
+	assert.Empty(t, item.Extra, "fields tagged - should be skipped")
+	assert.Zero(t, item.Kind, "enum fields should be skipped")
+	assert.Equal(t, time.UTC, item.At.Location(), "times should be UTC")
+	assert.Zero(t, item.At.Nanosecond(), "times should have a second precision")
+
+	var depth = 0
+	for next := item; next != nil; next = next.Next {
+		depth++
+	}
+	assert.Equal(t, maxDepth, depth, "nested structs should be populated up to the max depth")
+
+	var other = &testItem{}
+	Populate(other, rand.New(rand.NewSource(1)), "json")
+	assert.Equal(t, item, other, "the same seed should populate the same value")
+}
+
+func TestEqualJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{name: "same", a: `{"a":1,"b":[1,2]}`, b: `{"a":1,"b":[1,2]}`, expected: true},
+		{name: "key-order", a: `{"a":1,"b":2}`, b: `{ "b": 2, "a": 1 }`, expected: true},
+		{name: "value", a: `{"a":1}`, b: `{"a":2}`, expected: false},
+		{name: "array-order", a: `[1,2]`, b: `[2,1]`, expected: false},
+		{name: "invalid", a: `{"a":1`, b: `{"a":1`, expected: false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, EqualJSON([]byte(testCase.a), []byte(testCase.b)))
+		})
+	}
+}
+
+func TestTester(t *testing.T) {
+	var tester = &Tester{
+		New:        func() Object { return &testItem{} },
+		TagName:    "json",
+		Iterations: 5,
+	}
+	tester.RoundTrip(t)
+	tester.EncodingJSON(t)
+}
+
+func TestEqual(t *testing.T) {
+	type presence struct {
+		Name   string
+		fields gojay.FieldSet
+	}
+	type value struct {
+		Items  []*presence
+		Attrs  map[string]presence
+		fields gojay.FieldSet
+	}
+	var a = &value{Items: []*presence{{Name: "a"}}, Attrs: map[string]presence{"b": {Name: "b"}}}
+	var b = &value{Items: []*presence{{Name: "a", fields: 1}}, Attrs: map[string]presence{"b": {Name: "b", fields: 1}}, fields: 3}
+	assert.True(t, Equal(a, b), "field sets should be ignored")
+	b.Attrs["b"] = presence{Name: "c", fields: 1}
+	assert.False(t, Equal(a, b), "values should differ")
+	b.Attrs = nil
+	assert.False(t, Equal(a, b), "nil map should differ")
+}
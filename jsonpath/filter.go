@@ -0,0 +1,97 @@
+package jsonpath
+
+import (
+	"bytes"
+
+	"github.com/francoispqt/gojay"
+)
+
+const (
+	opOr      = "||"
+	opAnd     = "&&"
+	opNot     = "!"
+	opExists  = "exists"
+	opPath    = "path"
+	opLiteral = "literal"
+)
+
+// expr is a node of a filter expression.
+// Operands are either a relative path, stored as a JSON Pointer, or a raw JSON literal.
+type expr struct {
+	op          string
+	left, right *expr
+	pointer     string
+	value       []byte
+}
+
+// test evaluates the filter against the raw JSON value v.
+func (e *expr) test(v []byte) bool {
+	switch e.op {
+	case opOr:
+		return e.left.test(v) || e.right.test(v)
+	case opAnd:
+		return e.left.test(v) && e.right.test(v)
+	case opNot:
+		return !e.left.test(v)
+	case opExists:
+		_, err := gojay.Get(v, e.left.pointer)
+		return err == nil
+	}
+	a, aok := e.left.resolve(v)
+	b, bok := e.right.resolve(v)
+	var eq, lt, gt bool
+	if !aok || !bok {
+		eq = !aok && !bok
+	} else {
+		eq, lt, gt = compare(a, b)
+	}
+	switch e.op {
+	case "==":
+		return eq
+	case "!=":
+		return !eq
+	case "<":
+		return lt
+	case "<=":
+		return lt || eq
+	case ">":
+		return gt
+	case ">=":
+		return gt || eq
+	}
+	return false
+}
+
+// resolve returns the raw value of an operand, false if it references a missing value.
+func (e *expr) resolve(v []byte) ([]byte, bool) {
+	if e.op == opLiteral {
+		return e.value, true
+	}
+	b, err := gojay.Get(v, e.pointer)
+	return b, err == nil
+}
+
+// compare compares two raw JSON values, only numbers and strings are ordered.
+func compare(a, b []byte) (eq, lt, gt bool) {
+	switch {
+	case isNumber(a[0]) && isNumber(b[0]):
+		fa, errA := gojay.GetFloat64(a, "")
+		fb, errB := gojay.GetFloat64(b, "")
+		if errA != nil || errB != nil {
+			return false, false, false
+		}
+		return fa == fb, fa < fb, fa > fb
+	case a[0] == '"' && b[0] == '"':
+		sa, errA := gojay.GetString(a, "")
+		sb, errB := gojay.GetString(b, "")
+		if errA != nil || errB != nil {
+			return false, false, false
+		}
+		return sa == sb, sa < sb, sa > sb
+	}
+	return bytes.Equal(a, b), false, false
+}
+
+func isNumber(c byte) bool {
+	return c == '-' || isDigit(c)
+}
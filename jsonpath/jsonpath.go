@@ -0,0 +1,195 @@
+// Package jsonpath compiles JSONPath expressions and evaluates them over a gojay.Decoder.
+//
+// Evaluation is streaming: subtrees which can't match are skipped without being decoded
+// and only values which are tested by a filter are buffered.
+//
+// Supported syntax:
+//	$                root value
+//	.name ['name']   child member
+//	.* [*]           all children
+//	..name ..*       descendants
+//	[0] [0,2] [1:5:2]  array indexes, unions and slices (negative indexes are not supported)
+//	[?(@.price < 10)]  filters with ==, !=, <, <=, >, >=, &&, || and !
+package jsonpath
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/francoispqt/gojay"
+)
+
+// InvalidPathError is a type representing an error returned when
+// a JSONPath expression cannot be compiled.
+type InvalidPathError string
+
+func (err InvalidPathError) Error() string {
+	return string(err)
+}
+
+// Path is a compiled JSONPath expression.
+type Path struct {
+	expr  string
+	steps []step
+}
+
+// Compile parses a JSONPath expression.
+func Compile(expr string) (*Path, error) {
+	p := &parser{s: expr}
+	steps, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+	return &Path{expr: expr, steps: steps}, nil
+}
+
+// MustCompile is like Compile but panics if the expression cannot be compiled.
+func MustCompile(expr string) *Path {
+	p, err := Compile(expr)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns the source expression of the path.
+func (p *Path) String() string {
+	return p.expr
+}
+
+// Each evaluates the path against the next JSON value of the decoder's input
+// and calls fn for each match, in document order.
+//
+// The decoder given to fn is positioned on the matched value, fn must consume it
+// with one of the decoder's methods (dec.Object, dec.String, dec.EmbeddedJSON, dec.Skip...).
+// If the input is exhausted before a value is found, io.EOF is returned.
+func (p *Path) Each(dec *gojay.Decoder, fn func(*gojay.Decoder) error) error {
+	if dec.Peek() == gojay.InvalidKind {
+		return io.EOF
+	}
+	e := &evaluator{path: p, fn: fn}
+	return e.eval(dec, []int{0})
+}
+
+// Find evaluates the path against the next JSON value of the decoder's input and returns the matched values.
+func (p *Path) Find(dec *gojay.Decoder) ([]gojay.EmbeddedJSON, error) {
+	var matches []gojay.EmbeddedJSON
+	err := p.Each(dec, func(dec *gojay.Decoder) error {
+		var v gojay.EmbeddedJSON
+		if err := dec.EmbeddedJSON(&v); err != nil {
+			return err
+		}
+		matches = append(matches, v)
+		return nil
+	})
+	return matches, err
+}
+
+// FindBytes evaluates the path against data and returns the matched values.
+func (p *Path) FindBytes(data []byte) ([]gojay.EmbeddedJSON, error) {
+	dec := gojay.BorrowDecoder(bytes.NewReader(data))
+	defer dec.Release()
+	return p.Find(dec)
+}
+
+// evaluator walks a document keeping track of the steps of the path
+// each value can still reach, a state being the index of the next step to apply.
+// A value reaching the state len(steps) is a match.
+type evaluator struct {
+	path *Path
+	fn   func(*gojay.Decoder) error
+}
+
+func (e *evaluator) eval(dec *gojay.Decoder, states []int) error {
+	matched := false
+	rest := make([]int, 0, len(states))
+	for _, s := range states {
+		if s == len(e.path.steps) {
+			matched = true
+			continue
+		}
+		rest = append(rest, s)
+	}
+	if matched {
+		if len(rest) == 0 {
+			return e.fn(dec)
+		}
+		// the value is both a match and the parent of other matches, buffer it to read it twice
+		var v gojay.EmbeddedJSON
+		if err := dec.EmbeddedJSON(&v); err != nil {
+			return err
+		}
+		if err := e.evalBytes(v, []int{len(e.path.steps)}); err != nil {
+			return err
+		}
+		return e.evalBytes(v, rest)
+	}
+	switch dec.Peek() {
+	case gojay.ObjectKind:
+		return dec.Object(gojay.DecodeObjectFunc(func(dec *gojay.Decoder, k string) error {
+			return e.child(dec, rest, k, 0, false)
+		}))
+	case gojay.ArrayKind:
+		return dec.Array(gojay.DecodeArrayFunc(func(dec *gojay.Decoder) error {
+			return e.child(dec, rest, "", dec.Index(), true)
+		}))
+	}
+	return dec.Skip()
+}
+
+// child evaluates the member k or the element at index of the current value.
+func (e *evaluator) child(dec *gojay.Decoder, states []int, k string, index int, isArray bool) error {
+	var next, filters []int
+	for _, s := range states {
+		st := &e.path.steps[s]
+		if st.descendant {
+			next = addState(next, s)
+		}
+		for i := range st.selectors {
+			sel := &st.selectors[i]
+			if sel.kind == filterSelector {
+				filters = addState(filters, s)
+			} else if sel.match(k, index, isArray) {
+				next = addState(next, s+1)
+			}
+		}
+	}
+	if len(filters) == 0 {
+		if len(next) == 0 {
+			return dec.Skip()
+		}
+		return e.eval(dec, next)
+	}
+	// filters need the whole value before deciding which states it reaches
+	var v gojay.EmbeddedJSON
+	if err := dec.EmbeddedJSON(&v); err != nil {
+		return err
+	}
+	for _, s := range filters {
+		st := &e.path.steps[s]
+		for i := range st.selectors {
+			if st.selectors[i].kind == filterSelector && st.selectors[i].filter.test(v) {
+				next = addState(next, s+1)
+			}
+		}
+	}
+	if len(next) == 0 {
+		return nil
+	}
+	return e.evalBytes(v, next)
+}
+
+func (e *evaluator) evalBytes(v gojay.EmbeddedJSON, states []int) error {
+	dec := gojay.BorrowDecoder(bytes.NewReader(v))
+	defer dec.Release()
+	return e.eval(dec, states)
+}
+
+func addState(states []int, s int) []int {
+	for _, v := range states {
+		if v == s {
+			return states
+		}
+	}
+	return append(states, s)
+}
@@ -0,0 +1,179 @@
+package jsonpath
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/francoispqt/gojay"
+	"github.com/stretchr/testify/assert"
+)
+
+var storeJSON = `{
+	"store": {
+		"book": [
+			{"category": "reference", "author": "Nigel Rees", "title": "Sayings of the Century", "price": 8.95},
+			{"category": "fiction", "author": "Evelyn Waugh", "title": "Sword of Honour", "price": 12.99},
+			{"category": "fiction", "author": "Herman Melville", "title": "Moby Dick", "isbn": "0-553-21311-3", "price": 8.99},
+			{"category": "fiction", "author": "J. R. R. Tolkien", "title": "The Lord of the Rings", "isbn": "0-395-19395-8", "price": 22.99}
+		],
+		"bicycle": {"color": "red", "price": 19.95}
+	},
+	"expensive": 10
+}`
+
+func toStrings(matches []gojay.EmbeddedJSON) []string {
+	s := make([]string, len(matches))
+	for i, m := range matches {
+		s[i] = string(m)
+	}
+	return s
+}
+
+func TestFind(t *testing.T) {
+	testCases := []struct {
+		path     string
+		expected []string
+	}{
+		{path: "$", expected: []string{storeJSON}},
+		{path: "$.store.book[*].author", expected: []string{`"Nigel Rees"`, `"Evelyn Waugh"`, `"Herman Melville"`, `"J. R. R. Tolkien"`}},
+		{path: "$..author", expected: []string{`"Nigel Rees"`, `"Evelyn Waugh"`, `"Herman Melville"`, `"J. R. R. Tolkien"`}},
+		{path: "$.store.*.color", expected: []string{`"red"`}},
+		{path: "$.store..price", expected: []string{`8.95`, `12.99`, `8.99`, `22.99`, `19.95`}},
+		{path: "$..book[2].title", expected: []string{`"Moby Dick"`}},
+		{path: "$['store']['bicycle'][\"color\"]", expected: []string{`"red"`}},
+		{path: "$..book[0,1].title", expected: []string{`"Sayings of the Century"`, `"Sword of Honour"`}},
+		{path: "$..book[:2].price", expected: []string{`8.95`, `12.99`}},
+		{path: "$..book[1:].price", expected: []string{`12.99`, `8.99`, `22.99`}},
+		{path: "$..book[::2].price", expected: []string{`8.95`, `8.99`}},
+		{path: "$..book[?(@.isbn)].title", expected: []string{`"Moby Dick"`, `"The Lord of the Rings"`}},
+		{path: "$..book[?(!@.isbn)].title", expected: []string{`"Sayings of the Century"`, `"Sword of Honour"`}},
+		{path: "$.store.book[?(@.price < 10)].title", expected: []string{`"Sayings of the Century"`, `"Moby Dick"`}},
+		{path: "$.store.book[?(@.price >= 12.99 && @.category == 'fiction')].title", expected: []string{`"Sword of Honour"`, `"The Lord of the Rings"`}},
+		{path: "$.store.book[?(@.author == \"Nigel Rees\" || @.price > 20)].price", expected: []string{`8.95`, `22.99`}},
+		{path: "$.store.book[?@.category != 'fiction'].price", expected: []string{`8.95`}},
+		{path: "$.store.book[?(@['title'] <= 'Moby Dick')].price", expected: []string{`8.99`}},
+		{path: "$.store[?(@.color == 'red')].price", expected: []string{`19.95`}},
+		{path: "$.store.book[?(@.missing == null)].price", expected: []string{}},
+		{path: "$.store.book[?(@.missing == @.other)].price", expected: []string{`8.95`, `12.99`, `8.99`, `22.99`}},
+		{path: "$.store.bicycle", expected: []string{`{"color": "red", "price": 19.95}`}},
+		{path: "$..*.color", expected: []string{`"red"`}},
+		{path: "$.expensive", expected: []string{`10`}},
+		{path: "$.nope", expected: []string{}},
+		{path: "$.expensive.nope", expected: []string{}},
+		{path: "$.store.book[10]", expected: []string{}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.path, func(t *testing.T) {
+			p, err := Compile(testCase.path)
+			assert.Nil(t, err, "err should be nil")
+			matches, err := p.FindBytes([]byte(storeJSON))
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, toStrings(matches), "matches should be the expected ones")
+		})
+	}
+}
+
+func TestFindNestedMatches(t *testing.T) {
+	// a value can match while also being the parent of other matches
+	matches, err := MustCompile("$..a").FindBytes([]byte(`{"a":{"a":1},"b":[{"a":2}]}`))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, []string{`{"a":1}`, `1`, `2`}, toStrings(matches), "matches should be in document order")
+}
+
+type book struct {
+	title string
+	price float64
+}
+
+func (b *book) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	switch k {
+	case "title":
+		return dec.String(&b.title)
+	case "price":
+		return dec.Float64(&b.price)
+	}
+	return nil
+}
+
+func (b *book) NKeys() int {
+	return 0
+}
+
+func TestEachDecodeTargets(t *testing.T) {
+	var books []*book
+	p := MustCompile("$.store.book[?(@.price > 10)]")
+	dec := gojay.NewDecoder(strings.NewReader(storeJSON))
+	err := p.Each(dec, func(dec *gojay.Decoder) error {
+		b := &book{}
+		books = append(books, b)
+		return dec.Object(b)
+	})
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, []*book{{"Sword of Honour", 12.99}, {"The Lord of the Rings", 22.99}}, books)
+}
+
+func TestEachStream(t *testing.T) {
+	var ids []int
+	p := MustCompile("$.event[?(@.level == 'error')].id")
+	dec := gojay.NewDecoder(strings.NewReader(`{"event":[{"id":1,"level":"error"}]}
+{"event":[{"id":2,"level":"info"}]}
+{"event":[{"level":"error","id":3}]}
+`))
+	for {
+		err := p.Each(dec, func(dec *gojay.Decoder) error {
+			var id int
+			if err := dec.Int(&id); err != nil {
+				return err
+			}
+			ids = append(ids, id)
+			return nil
+		})
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err, "err should be nil")
+	}
+	assert.Equal(t, []int{1, 3}, ids, "ids should be the expected ones")
+}
+
+func TestFindInvalidJSON(t *testing.T) {
+	_, err := MustCompile("$.a[*]").FindBytes([]byte(`{"a":[1,tru]}`))
+	assert.IsType(t, gojay.InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+	_, err = MustCompile("$.a[?(@.b)]").FindBytes([]byte(`{"a":[{"b":1},tru]}`))
+	assert.IsType(t, gojay.InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+}
+
+func TestCompileErrors(t *testing.T) {
+	testCases := []string{
+		"",
+		"store",
+		"$.",
+		"$[",
+		"$[1",
+		"$['a",
+		"$[-1]",
+		"$[1:-1]",
+		"$[::0]",
+		"$[?(@.a < )]",
+		"$[?(@.a < 1]",
+		"$[?(@..a)]",
+		"$[?(@[*])]",
+		"$[?(@[1,2])]",
+		"$[?('a')]",
+		"$[?(@.a < (@.b))]",
+		"$[?(@.a == 1.)]",
+		"$.a b",
+		"$[}]",
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase, func(t *testing.T) {
+			_, err := Compile(testCase)
+			assert.IsType(t, InvalidPathError(""), err, "err should be of type InvalidPathError")
+		})
+	}
+	assert.Panics(t, func() {
+		MustCompile("a")
+	}, "MustCompile should panic")
+	assert.Equal(t, "$.a", MustCompile("$.a").String())
+}
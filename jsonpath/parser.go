@@ -0,0 +1,450 @@
+package jsonpath
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/francoispqt/gojay"
+)
+
+type selectorKind byte
+
+const (
+	nameSelector selectorKind = iota
+	wildcardSelector
+	indexSelector
+	sliceSelector
+	filterSelector
+)
+
+// step is a segment of a path, its selectors are applied to the children of the current values.
+// A descendant step is applied to the children of all the descendants as well.
+type step struct {
+	descendant bool
+	selectors  []selector
+}
+
+type selector struct {
+	kind  selectorKind
+	name  string
+	index int
+	// slice bounds, end is -1 when the slice is not bounded
+	start, end, step int
+	filter           *expr
+}
+
+func (sel *selector) match(k string, index int, isArray bool) bool {
+	switch sel.kind {
+	case wildcardSelector:
+		return true
+	case nameSelector:
+		return !isArray && k == sel.name
+	case indexSelector:
+		return isArray && index == sel.index
+	case sliceSelector:
+		return isArray && index >= sel.start && (sel.end < 0 || index < sel.end) && (index-sel.start)%sel.step == 0
+	}
+	return false
+}
+
+type parser struct {
+	s   string
+	pos int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return InvalidPathError(fmt.Sprintf("Invalid JSONPath at position %d: ", p.pos) + fmt.Sprintf(format, args...))
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *parser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+func (p *parser) skipSpaces() {
+	for !p.eof() && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *parser) parsePath() ([]step, error) {
+	p.skipSpaces()
+	if p.peek() != '$' {
+		return nil, p.errorf("expected '$'")
+	}
+	p.pos++
+	steps, err := p.parseSegments(false)
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if !p.eof() {
+		return nil, p.errorf("unexpected char '%c'", p.peek())
+	}
+	return steps, nil
+}
+
+// parseSegments parses segments until a char which can't start a segment,
+// relative paths in filters only allow child names and indexes.
+func (p *parser) parseSegments(relative bool) ([]step, error) {
+	var steps []step
+	for !p.eof() {
+		var st step
+		switch {
+		case strings.HasPrefix(p.s[p.pos:], ".."):
+			if relative {
+				return nil, p.errorf("descendant segments are not supported in filters")
+			}
+			p.pos += 2
+			st.descendant = true
+			if p.peek() == '[' {
+				sels, err := p.parseBracket(relative)
+				if err != nil {
+					return nil, err
+				}
+				st.selectors = sels
+				break
+			}
+			sel, err := p.parseDotSelector(relative)
+			if err != nil {
+				return nil, err
+			}
+			st.selectors = []selector{sel}
+		case p.peek() == '.':
+			p.pos++
+			sel, err := p.parseDotSelector(relative)
+			if err != nil {
+				return nil, err
+			}
+			st.selectors = []selector{sel}
+		case p.peek() == '[':
+			sels, err := p.parseBracket(relative)
+			if err != nil {
+				return nil, err
+			}
+			st.selectors = sels
+		default:
+			return steps, nil
+		}
+		steps = append(steps, st)
+	}
+	return steps, nil
+}
+
+func (p *parser) parseDotSelector(relative bool) (selector, error) {
+	if p.peek() == '*' && !relative {
+		p.pos++
+		return selector{kind: wildcardSelector}, nil
+	}
+	start := p.pos
+	for !p.eof() && isNameChar(p.s[p.pos]) {
+		p.pos++
+	}
+	if start == p.pos {
+		return selector{}, p.errorf("expected a member name")
+	}
+	return selector{kind: nameSelector, name: p.s[start:p.pos]}, nil
+}
+
+func isNameChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_' || c == '-' || c == '$' || c >= 0x80
+}
+
+func (p *parser) parseBracket(relative bool) ([]selector, error) {
+	p.pos++
+	var sels []selector
+	for {
+		p.skipSpaces()
+		sel, err := p.parseSelector(relative)
+		if err != nil {
+			return nil, err
+		}
+		if relative && sel.kind != nameSelector && sel.kind != indexSelector {
+			return nil, p.errorf("only names and indexes are supported in filters")
+		}
+		sels = append(sels, sel)
+		p.skipSpaces()
+		switch p.peek() {
+		case ',':
+			if relative {
+				return nil, p.errorf("unions are not supported in filters")
+			}
+			p.pos++
+		case ']':
+			p.pos++
+			return sels, nil
+		default:
+			return nil, p.errorf("expected ',' or ']'")
+		}
+	}
+}
+
+func (p *parser) parseSelector(relative bool) (selector, error) {
+	switch c := p.peek(); {
+	case c == '\'' || c == '"':
+		name, err := p.parseQuoted()
+		if err != nil {
+			return selector{}, err
+		}
+		return selector{kind: nameSelector, name: name}, nil
+	case c == '*':
+		p.pos++
+		return selector{kind: wildcardSelector}, nil
+	case c == '?' && !relative:
+		p.pos++
+		e, err := p.parseOr()
+		if err != nil {
+			return selector{}, err
+		}
+		return selector{kind: filterSelector, filter: e}, nil
+	case c == ':' || c == '-' || isDigit(c):
+		return p.parseIndexOrSlice()
+	}
+	return selector{}, p.errorf("unexpected char '%c'", p.peek())
+}
+
+func (p *parser) parseIndexOrSlice() (selector, error) {
+	start, ok, err := p.parseInt()
+	if err != nil {
+		return selector{}, err
+	}
+	p.skipSpaces()
+	if p.peek() != ':' {
+		if !ok {
+			return selector{}, p.errorf("expected an index")
+		}
+		return selector{kind: indexSelector, index: start}, nil
+	}
+	p.pos++
+	p.skipSpaces()
+	sel := selector{kind: sliceSelector, start: start, end: -1, step: 1}
+	end, ok, err := p.parseInt()
+	if err != nil {
+		return selector{}, err
+	}
+	if ok {
+		sel.end = end
+	}
+	p.skipSpaces()
+	if p.peek() == ':' {
+		p.pos++
+		p.skipSpaces()
+		step, ok, err := p.parseInt()
+		if err != nil {
+			return selector{}, err
+		}
+		if ok {
+			if step == 0 {
+				return selector{}, p.errorf("slice step must be positive")
+			}
+			sel.step = step
+		}
+	}
+	return sel, nil
+}
+
+// parseInt parses a non negative integer, negative integers can't be evaluated without knowing the array's length.
+func (p *parser) parseInt() (int, bool, error) {
+	if p.peek() == '-' {
+		return 0, false, p.errorf("negative indexes are not supported")
+	}
+	start := p.pos
+	n := 0
+	for !p.eof() && isDigit(p.s[p.pos]) {
+		n = n*10 + int(p.s[p.pos]-'0')
+		p.pos++
+	}
+	return n, p.pos > start, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// parseQuoted parses a single or double quoted string.
+func (p *parser) parseQuoted() (string, error) {
+	quote := p.s[p.pos]
+	p.pos++
+	var b strings.Builder
+	for !p.eof() {
+		c := p.s[p.pos]
+		p.pos++
+		switch c {
+		case quote:
+			return b.String(), nil
+		case '\\':
+			if p.eof() {
+				return "", p.errorf("unterminated string")
+			}
+			c = p.s[p.pos]
+			p.pos++
+			switch c {
+			case 'n':
+				c = '\n'
+			case 't':
+				c = '\t'
+			case 'r':
+				c = '\r'
+			}
+		}
+		b.WriteByte(c)
+	}
+	return "", p.errorf("unterminated string")
+}
+
+// filter expressions
+
+func (p *parser) parseOr() (*expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpaces()
+		if !strings.HasPrefix(p.s[p.pos:], "||") {
+			return left, nil
+		}
+		p.pos += 2
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &expr{op: opOr, left: left, right: right}
+	}
+}
+
+func (p *parser) parseAnd() (*expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpaces()
+		if !strings.HasPrefix(p.s[p.pos:], "&&") {
+			return left, nil
+		}
+		p.pos += 2
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &expr{op: opAnd, left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary() (*expr, error) {
+	p.skipSpaces()
+	if p.peek() == '!' && !strings.HasPrefix(p.s[p.pos:], "!=") {
+		p.pos++
+		e, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &expr{op: opNot, left: e}, nil
+	}
+	return p.parseComparison()
+}
+
+var comparisonOps = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+func (p *parser) parseComparison() (*expr, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	for _, op := range comparisonOps {
+		if strings.HasPrefix(p.s[p.pos:], op) {
+			p.pos += len(op)
+			right, err := p.parsePrimary()
+			if err != nil {
+				return nil, err
+			}
+			if left.op != opPath && left.op != opLiteral || right.op != opPath && right.op != opLiteral {
+				return nil, p.errorf("only paths and literals can be compared")
+			}
+			return &expr{op: op, left: left, right: right}, nil
+		}
+	}
+	switch left.op {
+	case opLiteral:
+		return nil, p.errorf("expected a comparison")
+	case opPath:
+		return &expr{op: opExists, left: left}, nil
+	}
+	return left, nil
+}
+
+func (p *parser) parsePrimary() (*expr, error) {
+	p.skipSpaces()
+	switch c := p.peek(); {
+	case c == '(':
+		p.pos++
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if p.peek() != ')' {
+			return nil, p.errorf("expected ')'")
+		}
+		p.pos++
+		return e, nil
+	case c == '@':
+		p.pos++
+		steps, err := p.parseSegments(true)
+		if err != nil {
+			return nil, err
+		}
+		return &expr{op: opPath, pointer: toPointer(steps)}, nil
+	case c == '\'' || c == '"':
+		s, err := p.parseQuoted()
+		if err != nil {
+			return nil, err
+		}
+		b, err := gojay.Marshal(s)
+		if err != nil {
+			return nil, err
+		}
+		return &expr{op: opLiteral, value: b}, nil
+	case c == '-' || isDigit(c):
+		start := p.pos
+		p.pos++
+		for !p.eof() && (isDigit(p.s[p.pos]) || strings.IndexByte(".eE+-", p.s[p.pos]) >= 0) {
+			p.pos++
+		}
+		b := []byte(p.s[start:p.pos])
+		if !gojay.Valid(b) {
+			return nil, p.errorf("invalid number '%s'", b)
+		}
+		return &expr{op: opLiteral, value: b}, nil
+	}
+	for _, lit := range []string{"true", "false", "null"} {
+		if strings.HasPrefix(p.s[p.pos:], lit) {
+			p.pos += len(lit)
+			return &expr{op: opLiteral, value: []byte(lit)}, nil
+		}
+	}
+	return nil, p.errorf("unexpected char '%c'", p.peek())
+}
+
+// toPointer converts the steps of a relative path to a JSON Pointer.
+func toPointer(steps []step) string {
+	var b strings.Builder
+	for _, st := range steps {
+		b.WriteByte('/')
+		sel := st.selectors[0]
+		if sel.kind == indexSelector {
+			fmt.Fprintf(&b, "%d", sel.index)
+			continue
+		}
+		b.WriteString(strings.Replace(strings.Replace(sel.name, "~", "~0", -1), "/", "~1", -1))
+	}
+	return b.String()
+}
@@ -0,0 +1,195 @@
+package patch
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+
+	"github.com/francoispqt/gojay"
+)
+
+// Equal reports whether a and b are equal JSON values.
+// Objects are compared regardless of the order of their keys and numbers are compared by their exact decimal value.
+func Equal(a, b []byte) (bool, error) {
+	ka, kb := kind(a), kind(b)
+	if isNumber(ka) && isNumber(kb) {
+		return equalNumbers(a, b)
+	}
+	if ka != kb {
+		return false, nil
+	}
+	switch ka {
+	case '{':
+		aKeys, aValues, err := members(a)
+		if err != nil {
+			return false, err
+		}
+		bKeys, bValues, err := members(b)
+		if err != nil {
+			return false, err
+		}
+		if len(aKeys) != len(bKeys) {
+			return false, nil
+		}
+		bIndex := index(bKeys)
+		for i, k := range aKeys {
+			j, ok := bIndex[k]
+			if !ok {
+				return false, nil
+			}
+			if ok, err := Equal(aValues[i], bValues[j]); !ok || err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	case '[':
+		aElts, err := elements(a)
+		if err != nil {
+			return false, err
+		}
+		bElts, err := elements(b)
+		if err != nil {
+			return false, err
+		}
+		if len(aElts) != len(bElts) {
+			return false, nil
+		}
+		for i := range aElts {
+			if ok, err := Equal(aElts[i], bElts[i]); !ok || err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	case '"':
+		var sa, sb string
+		if err := gojay.Unmarshal(copyBytes(a), &sa); err != nil {
+			return false, err
+		}
+		if err := gojay.Unmarshal(copyBytes(b), &sb); err != nil {
+			return false, err
+		}
+		return sa == sb, nil
+	}
+	return bytes.Equal(bytes.TrimSpace(a), bytes.TrimSpace(b)), nil
+}
+
+// kind returns the first char of a JSON value.
+func kind(b []byte) byte {
+	for _, c := range b {
+		switch c {
+		case ' ', '\n', '\t', '\r':
+			continue
+		}
+		return c
+	}
+	return 0
+}
+
+func isNumber(c byte) bool {
+	return c == '-' || (c >= '0' && c <= '9')
+}
+
+// equalNumbers compares JSON numbers by their exact decimal value, i.e. 1e2 equals 100,
+// without converting them to float64 which rounds integers above 2^53 and long fractions.
+func equalNumbers(a, b []byte) (bool, error) {
+	a, b = bytes.TrimSpace(a), bytes.TrimSpace(b)
+	if err := gojay.Validate(bytes.NewReader(a)); err != nil {
+		return false, err
+	}
+	if err := gojay.Validate(bytes.NewReader(b)); err != nil {
+		return false, err
+	}
+	if bytes.Equal(a, b) {
+		return true, nil
+	}
+	da, db := parseDecimal(a), parseDecimal(b)
+	return da.neg == db.neg && bytes.Equal(da.digits, db.digits) && da.exp.Cmp(db.exp) == 0, nil
+}
+
+// decimal is the value of a JSON number, digits × 10^exp, without leading nor trailing zero digits.
+type decimal struct {
+	neg    bool
+	digits []byte
+	exp    *big.Int
+}
+
+// parseDecimal parses a valid JSON number.
+func parseDecimal(b []byte) decimal {
+	var d = decimal{exp: new(big.Int)}
+	if b[0] == '-' {
+		d.neg = true
+		b = b[1:]
+	}
+	if i := bytes.IndexAny(b, "eE"); i >= 0 {
+		d.exp.SetString(string(b[i+1:]), 10)
+		b = b[:i]
+	}
+	if i := bytes.IndexByte(b, '.'); i >= 0 {
+		d.exp.Sub(d.exp, big.NewInt(int64(len(b)-i-1)))
+		d.digits = append(append(d.digits, b[:i]...), b[i+1:]...)
+	} else {
+		d.digits = append(d.digits, b...)
+	}
+	d.digits = bytes.TrimLeft(d.digits, "0")
+	n := len(d.digits)
+	d.digits = bytes.TrimRight(d.digits, "0")
+	d.exp.Add(d.exp, big.NewInt(int64(n-len(d.digits))))
+	if len(d.digits) == 0 {
+		// zero, whatever its sign and exponent
+		d.neg = false
+		d.exp.SetInt64(0)
+	}
+	return d
+}
+
+// members returns the keys and raw values of a JSON object.
+func members(b []byte) ([]string, []gojay.EmbeddedJSON, error) {
+	var keys []string
+	var values []gojay.EmbeddedJSON
+	err := gojay.UnmarshalJSONObject(b, gojay.DecodeObjectFunc(func(dec *gojay.Decoder, k string) error {
+		var v gojay.EmbeddedJSON
+		if err := dec.EmbeddedJSON(&v); err != nil {
+			return err
+		}
+		keys = append(keys, k)
+		values = append(values, v)
+		return nil
+	}))
+	return keys, values, err
+}
+
+// elements returns the raw values of a JSON array.
+func elements(b []byte) ([]gojay.EmbeddedJSON, error) {
+	var values []gojay.EmbeddedJSON
+	err := gojay.UnmarshalJSONArray(b, gojay.DecodeArrayFunc(func(dec *gojay.Decoder) error {
+		var v gojay.EmbeddedJSON
+		if err := dec.EmbeddedJSON(&v); err != nil {
+			return err
+		}
+		values = append(values, v)
+		return nil
+	}))
+	return values, err
+}
+
+func index(keys []string) map[string]int {
+	m := make(map[string]int, len(keys))
+	for i, k := range keys {
+		if _, ok := m[k]; !ok {
+			m[k] = i
+		}
+	}
+	return m
+}
+
+// escapeToken escapes a key to be used as a JSON Pointer reference token.
+func escapeToken(k string) string {
+	if strings.IndexAny(k, "~/") < 0 {
+		return k
+	}
+	return strings.Replace(strings.Replace(k, "~", "~0", -1), "/", "~1", -1)
+}
+
+func copyBytes(b []byte) []byte {
+	return append([]byte(nil), bytes.TrimSpace(b)...)
+}
@@ -0,0 +1,96 @@
+package patch
+
+import (
+	"github.com/francoispqt/gojay"
+)
+
+var nullJSON = gojay.EmbeddedJSON("null")
+
+// Merge applies the JSON Merge Patch (RFC 7386) patch to doc and returns the patched document.
+func Merge(doc, patch []byte) ([]byte, error) {
+	if kind(patch) != '{' {
+		return copyBytes(patch), nil
+	}
+	if kind(doc) != '{' {
+		doc = []byte("{}")
+	}
+	keys, values, err := members(patch)
+	if err != nil {
+		return nil, err
+	}
+	for i, k := range keys {
+		pointer := "/" + escapeToken(k)
+		if kind(values[i]) == 'n' {
+			b, err := gojay.Delete(doc, pointer)
+			if err == gojay.ErrPointerNotFound {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			doc = b
+			continue
+		}
+		v, err := gojay.Get(doc, pointer)
+		if err != nil && err != gojay.ErrPointerNotFound {
+			return nil, err
+		}
+		v, err = Merge(v, values[i])
+		if err != nil {
+			return nil, err
+		}
+		doc, err = gojay.Set(doc, pointer, gojay.EmbeddedJSON(v))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return doc, nil
+}
+
+// CreateMergePatch returns a JSON Merge Patch document which transforms original into modified when applied with Merge.
+func CreateMergePatch(original, modified []byte) ([]byte, error) {
+	if kind(original) != '{' || kind(modified) != '{' {
+		return copyBytes(modified), nil
+	}
+	oKeys, oValues, err := members(original)
+	if err != nil {
+		return nil, err
+	}
+	mKeys, mValues, err := members(modified)
+	if err != nil {
+		return nil, err
+	}
+	oIndex, mIndex := index(oKeys), index(mKeys)
+	b, mErr := gojay.MarshalJSONObject(gojay.EncodeObjectFunc(func(enc *gojay.Encoder) {
+		for _, k := range oKeys {
+			if _, ok := mIndex[k]; !ok {
+				enc.AddEmbeddedJSONKey(k, &nullJSON)
+			}
+		}
+		for i, k := range mKeys {
+			if err != nil {
+				return
+			}
+			j, ok := oIndex[k]
+			if !ok {
+				enc.AddEmbeddedJSONKey(k, &mValues[i])
+				continue
+			}
+			var equal bool
+			equal, err = Equal(oValues[j], mValues[i])
+			if err != nil || equal {
+				continue
+			}
+			if kind(oValues[j]) == '{' && kind(mValues[i]) == '{' {
+				var sub gojay.EmbeddedJSON
+				sub, err = CreateMergePatch(oValues[j], mValues[i])
+				enc.AddEmbeddedJSONKey(k, &sub)
+				continue
+			}
+			enc.AddEmbeddedJSONKey(k, &mValues[i])
+		}
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return b, mErr
+}
@@ -0,0 +1,74 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// test cases are taken from RFC 7386 appendix A
+var mergeTestCases = []struct {
+	doc      string
+	patch    string
+	expected string
+}{
+	{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+	{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+	{`{"a":"b"}`, `{"a":null}`, `{}`},
+	{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+	{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+	{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+	{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+	{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+	{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+	{`{"a":"b"}`, `["c"]`, `["c"]`},
+	{`{"a":"foo"}`, `null`, `null`},
+	{`{"a":"foo"}`, `"bar"`, `"bar"`},
+	{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+	{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+	{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+}
+
+func TestMerge(t *testing.T) {
+	for _, testCase := range mergeTestCases {
+		t.Run(testCase.patch, func(t *testing.T) {
+			b, err := Merge([]byte(testCase.doc), []byte(testCase.patch))
+			assert.Nil(t, err, "err should be nil")
+			assertJSONEqual(t, testCase.expected, string(b))
+		})
+	}
+}
+
+func TestMergeEscapedKeys(t *testing.T) {
+	b, err := Merge([]byte(`{"a/b":1,"c~d":2}`), []byte(`{"a/b":null,"c~d":3}`))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"c~d":3}`, string(b))
+}
+
+func TestCreateMergePatch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		original string
+		modified string
+		expected string
+	}{
+		{name: "unchanged", original: `{"a":1,"b":{"c":[1]}}`, modified: `{"b":{"c":[1]},"a":1}`, expected: `{}`},
+		{name: "changed", original: `{"a":1}`, modified: `{"a":2}`, expected: `{"a":2}`},
+		{name: "added", original: `{"a":1}`, modified: `{"a":1,"b":true}`, expected: `{"b":true}`},
+		{name: "removed", original: `{"a":1,"b":true}`, modified: `{"a":1}`, expected: `{"b":null}`},
+		{name: "nested", original: `{"a":{"b":1,"c":2}}`, modified: `{"a":{"b":1,"d":3}}`, expected: `{"a":{"c":null,"d":3}}`},
+		{name: "type-change", original: `{"a":{"b":1}}`, modified: `{"a":[1]}`, expected: `{"a":[1]}`},
+		{name: "non-object", original: `{"a":1}`, modified: ` [1] `, expected: `[1]`},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			b, err := CreateMergePatch([]byte(testCase.original), []byte(testCase.modified))
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, string(b))
+			// applying the created patch must give the modified document
+			merged, err := Merge([]byte(testCase.original), b)
+			assert.Nil(t, err, "err should be nil")
+			assertJSONEqual(t, testCase.modified, string(merged))
+		})
+	}
+}
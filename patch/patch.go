@@ -0,0 +1,182 @@
+// Package patch applies JSON Patch (RFC 6902) and JSON Merge Patch (RFC 7386) documents to raw JSON.
+//
+// Documents are never decoded to a generic representation,
+// patches are applied by rewriting the affected byte ranges using gojay's pointer functions.
+package patch
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/francoispqt/gojay"
+)
+
+// ErrTestFailed is the error returned when a test operation does not match the document.
+var ErrTestFailed = errors.New("JSON Patch test operation failed")
+
+// InvalidOperationError is a type representing an error returned when
+// a JSON Patch operation is not valid.
+type InvalidOperationError string
+
+func (err InvalidOperationError) Error() string {
+	return string(err)
+}
+
+// Operation is a single JSON Patch operation.
+type Operation struct {
+	Op    string
+	Path  string
+	From  string
+	Value gojay.EmbeddedJSON
+}
+
+// UnmarshalJSONObject implements gojay.UnmarshalerJSONObject.
+func (o *Operation) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	switch k {
+	case "op":
+		return dec.String(&o.Op)
+	case "path":
+		return dec.String(&o.Path)
+	case "from":
+		return dec.String(&o.From)
+	case "value":
+		return dec.EmbeddedJSON(&o.Value)
+	}
+	return nil
+}
+
+// NKeys implements gojay.UnmarshalerJSONObject.
+func (o *Operation) NKeys() int {
+	return 0
+}
+
+// MarshalJSONObject implements gojay.MarshalerJSONObject.
+func (o *Operation) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.StringKey("op", o.Op)
+	enc.StringKey("path", o.Path)
+	enc.StringKeyOmitEmpty("from", o.From)
+	enc.AddEmbeddedJSONKeyOmitEmpty("value", &o.Value)
+}
+
+// IsNil implements gojay.MarshalerJSONObject.
+func (o *Operation) IsNil() bool {
+	return o == nil
+}
+
+// Patch is a JSON Patch document, a list of operations applied in order.
+type Patch []*Operation
+
+// UnmarshalJSONArray implements gojay.UnmarshalerJSONArray.
+func (p *Patch) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	o := &Operation{}
+	if err := dec.Object(o); err != nil {
+		return err
+	}
+	*p = append(*p, o)
+	return nil
+}
+
+// MarshalJSONArray implements gojay.MarshalerJSONArray.
+func (p Patch) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, o := range p {
+		enc.Object(o)
+	}
+}
+
+// IsNil implements gojay.MarshalerJSONArray.
+func (p Patch) IsNil() bool {
+	return p == nil
+}
+
+// Decode decodes a JSON Patch document.
+func Decode(b []byte) (Patch, error) {
+	var p Patch
+	if err := gojay.UnmarshalJSONArray(b, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Apply decodes the JSON Patch document patch and applies it to doc.
+func Apply(doc, patch []byte) ([]byte, error) {
+	p, err := Decode(patch)
+	if err != nil {
+		return nil, err
+	}
+	return p.Apply(doc)
+}
+
+// Apply applies the operations of the patch to doc and returns the patched document.
+// Operations are applied in order, if one fails the error is returned and doc is left untouched.
+func (p Patch) Apply(doc []byte) ([]byte, error) {
+	var err error
+	for _, o := range p {
+		doc, err = o.Apply(doc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return doc, nil
+}
+
+// Apply applies the operation to doc and returns the patched document.
+func (o *Operation) Apply(doc []byte) ([]byte, error) {
+	switch o.Op {
+	case "add":
+		if len(o.Value) == 0 {
+			return nil, InvalidOperationError("Missing value in add operation")
+		}
+		return gojay.Insert(doc, o.Path, o.Value)
+	case "remove":
+		return gojay.Delete(doc, o.Path)
+	case "replace":
+		if len(o.Value) == 0 {
+			return nil, InvalidOperationError("Missing value in replace operation")
+		}
+		if _, err := gojay.Get(doc, o.Path); err != nil {
+			return nil, err
+		}
+		return gojay.Set(doc, o.Path, o.Value)
+	case "move":
+		if o.From == o.Path {
+			return doc, nil
+		}
+		if strings.HasPrefix(o.Path, o.From+"/") {
+			return nil, InvalidOperationError("Cannot move a value into one of its children")
+		}
+		v, err := gojay.Get(doc, o.From)
+		if err != nil {
+			return nil, err
+		}
+		// v is a sub slice of doc which is copied by Delete
+		doc, err = gojay.Delete(doc, o.From)
+		if err != nil {
+			return nil, err
+		}
+		return gojay.Insert(doc, o.Path, gojay.EmbeddedJSON(v))
+	case "copy":
+		v, err := gojay.Get(doc, o.From)
+		if err != nil {
+			return nil, err
+		}
+		return gojay.Insert(doc, o.Path, gojay.EmbeddedJSON(v))
+	case "test":
+		if len(o.Value) == 0 {
+			return nil, InvalidOperationError("Missing value in test operation")
+		}
+		v, err := gojay.Get(doc, o.Path)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := Equal(v, o.Value)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, ErrTestFailed
+		}
+		return doc, nil
+	}
+	return nil, InvalidOperationError(fmt.Sprintf("Unknown operation '%s'", o.Op))
+}
@@ -0,0 +1,259 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/francoispqt/gojay"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertJSONEqual(t *testing.T, expected, actual string) {
+	ok, err := Equal([]byte(expected), []byte(actual))
+	assert.Nil(t, err, "err should be nil")
+	assert.True(t, ok, "expected %s, got %s", expected, actual)
+}
+
+// test cases are taken from RFC 6902 appendix A
+func TestApply(t *testing.T) {
+	testCases := []struct {
+		name     string
+		doc      string
+		patch    string
+		expected string
+		err      error
+	}{
+		{
+			name:     "add-object-member",
+			doc:      `{"foo":"bar"}`,
+			patch:    `[{"op":"add","path":"/baz","value":"qux"}]`,
+			expected: `{"baz":"qux","foo":"bar"}`,
+		},
+		{
+			name:     "add-array-element",
+			doc:      `{"foo":["bar","baz"]}`,
+			patch:    `[{"op":"add","path":"/foo/1","value":"qux"}]`,
+			expected: `{"foo":["bar","qux","baz"]}`,
+		},
+		{
+			name:     "remove-object-member",
+			doc:      `{"baz":"qux","foo":"bar"}`,
+			patch:    `[{"op":"remove","path":"/baz"}]`,
+			expected: `{"foo":"bar"}`,
+		},
+		{
+			name:     "remove-array-element",
+			doc:      `{"foo":["bar","qux","baz"]}`,
+			patch:    `[{"op":"remove","path":"/foo/1"}]`,
+			expected: `{"foo":["bar","baz"]}`,
+		},
+		{
+			name:     "replace-value",
+			doc:      `{"baz":"qux","foo":"bar"}`,
+			patch:    `[{"op":"replace","path":"/baz","value":"boo"}]`,
+			expected: `{"baz":"boo","foo":"bar"}`,
+		},
+		{
+			name:     "move-value",
+			doc:      `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			patch:    `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			expected: `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`,
+		},
+		{
+			name:     "move-array-element",
+			doc:      `{"foo":["all","grass","cows","eat"]}`,
+			patch:    `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`,
+			expected: `{"foo":["all","cows","eat","grass"]}`,
+		},
+		{
+			name:     "test-success",
+			doc:      `{"baz":"qux","foo":["a",2,"c"]}`,
+			patch:    `[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2.0}]`,
+			expected: `{"baz":"qux","foo":["a",2,"c"]}`,
+		},
+		{
+			name:  "test-failure",
+			doc:   `{"baz":"qux"}`,
+			patch: `[{"op":"test","path":"/baz","value":"bar"}]`,
+			err:   ErrTestFailed,
+		},
+		{
+			name:  "test-failure-large-integer",
+			doc:   `{"id":9007199254740993}`,
+			patch: `[{"op":"test","path":"/id","value":9007199254740992}]`,
+			err:   ErrTestFailed,
+		},
+		{
+			name:     "add-nested-member",
+			doc:      `{"foo":"bar"}`,
+			patch:    `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`,
+			expected: `{"foo":"bar","child":{"grandchild":{}}}`,
+		},
+		{
+			name:     "ignore-unrecognized-elements",
+			doc:      `{"foo":"bar"}`,
+			patch:    `[{"op":"add","path":"/baz","value":"qux","xyz":123}]`,
+			expected: `{"foo":"bar","baz":"qux"}`,
+		},
+		{
+			name:  "add-to-nonexistent-target",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"/baz/bat","value":"qux"}]`,
+			err:   gojay.ErrPointerNotFound,
+		},
+		{
+			name:     "escape-ordering",
+			doc:      `{"/":9,"~1":10}`,
+			patch:    `[{"op":"test","path":"/~01","value":10}]`,
+			expected: `{"/":9,"~1":10}`,
+		},
+		{
+			name:  "comparing-strings-and-numbers",
+			doc:   `{"/":9,"~1":10}`,
+			patch: `[{"op":"test","path":"/~01","value":"10"}]`,
+			err:   ErrTestFailed,
+		},
+		{
+			name:     "add-array-value",
+			doc:      `{"foo":["bar"]}`,
+			patch:    `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`,
+			expected: `{"foo":["bar",["abc","def"]]}`,
+		},
+		{
+			name:     "add-null",
+			doc:      `{"foo":"bar"}`,
+			patch:    `[{"op":"add","path":"/baz","value":null}]`,
+			expected: `{"foo":"bar","baz":null}`,
+		},
+		{
+			name:     "add-root",
+			doc:      `{"foo":"bar"}`,
+			patch:    `[{"op":"add","path":"","value":[1]}]`,
+			expected: `[1]`,
+		},
+		{
+			name:     "copy-value",
+			doc:      `{"foo":{"bar":1}}`,
+			patch:    `[{"op":"copy","from":"/foo","path":"/baz"}]`,
+			expected: `{"foo":{"bar":1},"baz":{"bar":1}}`,
+		},
+		{
+			name:  "copy-missing",
+			doc:   `{"foo":{"bar":1}}`,
+			patch: `[{"op":"copy","from":"/bar","path":"/baz"}]`,
+			err:   gojay.ErrPointerNotFound,
+		},
+		{
+			name:  "replace-missing",
+			doc:   `{"foo":[1]}`,
+			patch: `[{"op":"replace","path":"/foo/1","value":2}]`,
+			err:   gojay.ErrPointerNotFound,
+		},
+		{
+			name:  "remove-missing",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"remove","path":"/baz"}]`,
+			err:   gojay.ErrPointerNotFound,
+		},
+		{
+			name:  "move-missing",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"move","from":"/baz","path":"/foo"}]`,
+			err:   gojay.ErrPointerNotFound,
+		},
+		{
+			name:  "move-into-child",
+			doc:   `{"foo":{"bar":1}}`,
+			patch: `[{"op":"move","from":"/foo","path":"/foo/bar"}]`,
+			err:   InvalidOperationError("Cannot move a value into one of its children"),
+		},
+		{
+			name:     "move-same-path",
+			doc:      `{"foo":1}`,
+			patch:    `[{"op":"move","from":"/foo","path":"/foo"}]`,
+			expected: `{"foo":1}`,
+		},
+		{
+			name:  "add-missing-value",
+			doc:   `{}`,
+			patch: `[{"op":"add","path":"/foo"}]`,
+			err:   InvalidOperationError("Missing value in add operation"),
+		},
+		{
+			name:  "replace-missing-value",
+			doc:   `{"foo":1}`,
+			patch: `[{"op":"replace","path":"/foo"}]`,
+			err:   InvalidOperationError("Missing value in replace operation"),
+		},
+		{
+			name:  "test-missing-value",
+			doc:   `{"foo":1}`,
+			patch: `[{"op":"test","path":"/foo"}]`,
+			err:   InvalidOperationError("Missing value in test operation"),
+		},
+		{
+			name:  "unknown-operation",
+			doc:   `{}`,
+			patch: `[{"op":"foo","path":"/foo"}]`,
+			err:   InvalidOperationError("Unknown operation 'foo'"),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			b, err := Apply([]byte(testCase.doc), []byte(testCase.patch))
+			if testCase.err != nil {
+				assert.Equal(t, testCase.err, err, "err should be the expected one")
+				return
+			}
+			assert.Nil(t, err, "err should be nil")
+			assertJSONEqual(t, testCase.expected, string(b))
+		})
+	}
+}
+
+func TestApplyInvalidPatch(t *testing.T) {
+	_, err := Apply([]byte(`{}`), []byte(`{"op":"add"}`))
+	assert.IsType(t, gojay.InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+	_, err = Apply([]byte(`{}`), []byte(`[{"op":"add",}`))
+	assert.IsType(t, gojay.InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+}
+
+func TestPatchMarshal(t *testing.T) {
+	p, err := Decode([]byte(`[{"op":"add","path":"/a","value":{"b":1}},{"op":"move","from":"/a","path":"/c"}]`))
+	assert.Nil(t, err, "err should be nil")
+	b, err := gojay.MarshalJSONArray(p)
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `[{"op":"add","path":"/a","value":{"b":1}},{"op":"move","path":"/c","from":"/a"}]`, string(b))
+}
+
+func TestEqual(t *testing.T) {
+	testCases := []struct {
+		name  string
+		a     string
+		b     string
+		equal bool
+	}{
+		{name: "objects-key-order", a: `{"a":1,"b":[1,{"c":null}]}`, b: ` {"b":[1,{"c":null}],"a":1.0}`, equal: true},
+		{name: "objects-different-length", a: `{"a":1}`, b: `{"a":1,"b":2}`},
+		{name: "objects-different-keys", a: `{"a":1}`, b: `{"b":1}`},
+		{name: "objects-different-values", a: `{"a":1}`, b: `{"a":2}`},
+		{name: "arrays-order", a: `[1,2]`, b: `[2,1]`},
+		{name: "arrays-length", a: `[1,2]`, b: `[1]`},
+		{name: "strings-escaped", a: `"a/b"`, b: `"a\/b"`, equal: true},
+		{name: "numbers", a: `-1e2`, b: `-100`, equal: true},
+		{name: "numbers-fraction", a: `1.50`, b: `15e-1`, equal: true},
+		{name: "numbers-zero", a: `-0.0`, b: `0e10`, equal: true},
+		{name: "numbers-large-integers", a: `9007199254740993`, b: `9007199254740992`},
+		{name: "numbers-long-fractions", a: `0.10000000000000000001`, b: `0.1`},
+		{name: "numbers-large-exponents", a: `1e400`, b: `10E+399`, equal: true},
+		{name: "numbers-sign", a: `-1`, b: `1`},
+		{name: "literals", a: `true`, b: ` true `, equal: true},
+		{name: "different-kinds", a: `true`, b: `"true"`},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ok, err := Equal([]byte(testCase.a), []byte(testCase.b))
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.equal, ok, "result should be the expected one")
+		})
+	}
+}